@@ -0,0 +1,67 @@
+package apikeys
+
+import (
+	"context"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/rchauhan9/sportech/auth"
+)
+
+type listKeysRequest struct{}
+
+type listKeysResponse struct {
+	APIKeys []APIKey `json:"apiKeys"`
+}
+
+type issueKeyRequest struct {
+	Name   string       `json:"name"`
+	Scopes []auth.Scope `json:"scopes"`
+}
+
+// issueKeyResponse is the only response to carry a key itself.
+type issueKeyResponse struct {
+	APIKey APIKey `json:"apiKey"`
+	Key    string `json:"key"`
+}
+
+type rotateKeyRequest struct {
+	ID string
+}
+
+type revokeKeyRequest struct {
+	ID string
+}
+
+type revokeKeyResponse struct {
+	APIKey APIKey `json:"apiKey"`
+}
+
+func MakeListKeysEndpoint(svc Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		keys, err := svc.ListKeys(ctx)
+		return listKeysResponse{APIKeys: keys}, err
+	}
+}
+
+func MakeIssueKeyEndpoint(svc Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(issueKeyRequest)
+		key, err := svc.IssueKey(ctx, req.Name, req.Scopes)
+		return issueKeyResponse{APIKey: key.APIKey, Key: key.Key}, err
+	}
+}
+
+func MakeRotateKeyEndpoint(svc Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(rotateKeyRequest)
+		key, err := svc.RotateKey(ctx, req.ID)
+		return issueKeyResponse{APIKey: key.APIKey, Key: key.Key}, err
+	}
+}
+
+func MakeRevokeKeyEndpoint(svc Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(revokeKeyRequest)
+		key, err := svc.RevokeKey(ctx, req.ID)
+		return revokeKeyResponse{APIKey: key}, err
+	}
+}
@@ -0,0 +1,114 @@
+package apikeys
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/go-kit/kit/endpoint"
+	kithttp "github.com/go-kit/kit/transport/http"
+	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+	"github.com/rchauhan9/sportech/auth"
+	"github.com/rchauhan9/sportech/commons/go/logging"
+	"net/http"
+)
+
+func MakeHandler(listKeysEndpoint endpoint.Endpoint, issueKeyEndpoint endpoint.Endpoint, rotateKeyEndpoint endpoint.Endpoint, revokeKeyEndpoint endpoint.Endpoint) http.Handler {
+	r := mux.NewRouter()
+
+	listKeysHandler := kithttp.NewServer(
+		listKeysEndpoint,
+		decodeListKeysRequest,
+		encodeResponse,
+		kithttp.ServerErrorEncoder(encodeError),
+	)
+
+	issueKeyHandler := kithttp.NewServer(
+		issueKeyEndpoint,
+		decodeIssueKeyRequest,
+		encodeResponse,
+		kithttp.ServerErrorEncoder(encodeError),
+	)
+
+	rotateKeyHandler := kithttp.NewServer(
+		rotateKeyEndpoint,
+		decodeRotateKeyRequest,
+		encodeResponse,
+		kithttp.ServerErrorEncoder(encodeError),
+	)
+
+	revokeKeyHandler := kithttp.NewServer(
+		revokeKeyEndpoint,
+		decodeRevokeKeyRequest,
+		encodeResponse,
+		kithttp.ServerErrorEncoder(encodeError),
+	)
+
+	r.Handle("/admin/api-keys", listKeysHandler).Methods("GET")
+	r.Handle("/admin/api-keys", issueKeyHandler).Methods("POST")
+	r.Handle("/admin/api-keys/{id}/rotate", rotateKeyHandler).Methods("POST")
+	r.Handle("/admin/api-keys/{id}/revoke", revokeKeyHandler).Methods("POST")
+
+	return r
+}
+
+func decodeListKeysRequest(_ context.Context, _ *http.Request) (interface{}, error) {
+	return listKeysRequest{}, nil
+}
+
+func decodeIssueKeyRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req issueKeyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, errors.Wrapf(ErrInvalidArgument, "invalid request body: %s", err)
+	}
+	return req, nil
+}
+
+func decodeRotateKeyRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	vars := mux.Vars(r)
+	id, ok := vars["id"]
+	if !ok {
+		return nil, errors.New("bad route")
+	}
+	return rotateKeyRequest{ID: id}, nil
+}
+
+func decodeRevokeKeyRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	vars := mux.Vars(r)
+	id, ok := vars["id"]
+	if !ok {
+		return nil, errors.New("bad route")
+	}
+	return revokeKeyRequest{ID: id}, nil
+}
+
+func encodeResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	if e, ok := response.(errorer); ok && e.error() != nil {
+		encodeError(ctx, e.error(), w)
+		return nil
+	}
+	w.Header().Set("Cache-Control", "no-store")
+	return json.NewEncoder(w).Encode(response)
+}
+
+type errorer interface {
+	error() error
+}
+
+// encode errors from business-logic
+func encodeError(ctx context.Context, err error, w http.ResponseWriter) {
+	logging.RecordError(ctx, err)
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	switch errors.Cause(err) {
+	case ErrInvalidArgument:
+		w.WriteHeader(http.StatusBadRequest)
+	case auth.ErrUnauthenticated:
+		w.WriteHeader(http.StatusUnauthorized)
+	case auth.ErrForbidden:
+		w.WriteHeader(http.StatusForbidden)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"error": err.Error(),
+	})
+}
@@ -0,0 +1,24 @@
+package apikeys
+
+import (
+	"github.com/rchauhan9/sportech/auth"
+	"time"
+)
+
+// APIKey describes a key without the key itself, which is only ever stored hashed. Prefix is the
+// start of the key, enough to tell keys apart.
+type APIKey struct {
+	ID          string       `json:"id"`
+	Name        string       `json:"name"`
+	Prefix      string       `json:"prefix"`
+	Scopes      []auth.Scope `json:"scopes"`
+	CreatedAt   time.Time    `json:"createdAt"`
+	RotatedFrom *string      `json:"rotatedFrom"`
+	RevokedAt   *time.Time   `json:"revokedAt"`
+}
+
+// IssuedKey is a newly issued key. Key is returned this once and cannot be recovered.
+type IssuedKey struct {
+	APIKey APIKey
+	Key    string
+}
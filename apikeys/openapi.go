@@ -0,0 +1,45 @@
+package apikeys
+
+import (
+	"github.com/rchauhan9/sportech/auth"
+	"github.com/rchauhan9/sportech/openapi"
+	"net/http"
+)
+
+var Routes = []openapi.Route{
+	{
+		Method:   http.MethodGet,
+		Path:     "/admin/api-keys",
+		Tag:      "admin",
+		Summary:  "List API keys",
+		Response: listKeysResponse{},
+		Scope:    auth.ScopeAdmin,
+	},
+	{
+		Method:      http.MethodPost,
+		Path:        "/admin/api-keys",
+		Tag:         "admin",
+		Summary:     "Issue an API key",
+		Description: "The key is only ever returned in this response.",
+		Request:     issueKeyRequest{},
+		Response:    issueKeyResponse{},
+		Scope:       auth.ScopeAdmin,
+	},
+	{
+		Method:      http.MethodPost,
+		Path:        "/admin/api-keys/{id}/rotate",
+		Tag:         "admin",
+		Summary:     "Replace an API key with a new one",
+		Description: "The new key keeps the old one's name and scopes. The old key stops working straight away.",
+		Response:    issueKeyResponse{},
+		Scope:       auth.ScopeAdmin,
+	},
+	{
+		Method:   http.MethodPost,
+		Path:     "/admin/api-keys/{id}/revoke",
+		Tag:      "admin",
+		Summary:  "Revoke an API key",
+		Response: revokeKeyResponse{},
+		Scope:    auth.ScopeAdmin,
+	},
+}
@@ -0,0 +1,159 @@
+package apikeys
+
+import (
+	"context"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/pkg/errors"
+	"github.com/rchauhan9/sportech/auth"
+	"github.com/samber/lo"
+)
+
+type Repository interface {
+	ListKeys(ctx context.Context) ([]APIKey, error)
+	GetActiveKey(ctx context.Context, hash string) (APIKey, error)
+	CreateKey(ctx context.Context, name string, prefix string, hash string, scopes []auth.Scope) (APIKey, error)
+	EnsureKey(ctx context.Context, name string, prefix string, hash string, scopes []auth.Scope) error
+	RotateKey(ctx context.Context, id string, prefix string, hash string) (APIKey, error)
+	RevokeKey(ctx context.Context, id string) (APIKey, error)
+}
+
+func NewRepository(dbPool *pgxpool.Pool) Repository {
+	return &repository{dbPool}
+}
+
+type repository struct {
+	pool *pgxpool.Pool
+}
+
+const columns = `id, name, prefix, scopes, created_at, rotated_from, revoked_at`
+
+func (r *repository) ListKeys(ctx context.Context) ([]APIKey, error) {
+	query := `
+	    SELECT ` + columns + `
+	    FROM api_keys
+	    ORDER BY created_at, name
+	`
+	rows, err := r.pool.Query(ctx, query)
+	if err != nil {
+		return nil, errors.Wrap(err, "error fetching api keys from database")
+	}
+	defer rows.Close()
+
+	var keys []APIKey
+	for rows.Next() {
+		key, err := scanKey(rows)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return keys, rows.Err()
+}
+
+// GetActiveKey finds the unrevoked key with hash, returning auth.ErrUnauthenticated if there is
+// none.
+func (r *repository) GetActiveKey(ctx context.Context, hash string) (APIKey, error) {
+	query := `
+	    SELECT ` + columns + `
+	    FROM api_keys
+	    WHERE key_hash = $1 AND revoked_at IS NULL
+	`
+	key, err := scanKey(r.pool.QueryRow(ctx, query, hash))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return APIKey{}, errors.Wrap(auth.ErrUnauthenticated, "unknown or revoked api key")
+	}
+	return key, err
+}
+
+func (r *repository) CreateKey(ctx context.Context, name string, prefix string, hash string, scopes []auth.Scope) (APIKey, error) {
+	query := `
+	    INSERT INTO api_keys (name, prefix, key_hash, scopes)
+	    VALUES
+	    ($1, $2, $3, $4)
+	    RETURNING ` + columns
+	return scanKey(r.pool.QueryRow(ctx, query, name, prefix, hash, scopeStrings(scopes)))
+}
+
+// EnsureKey creates the key unless one with the same hash already exists, revoked or not.
+func (r *repository) EnsureKey(ctx context.Context, name string, prefix string, hash string, scopes []auth.Scope) error {
+	query := `
+	    INSERT INTO api_keys (name, prefix, key_hash, scopes)
+	    VALUES
+	    ($1, $2, $3, $4)
+	    ON CONFLICT (key_hash) DO NOTHING
+	`
+	if _, err := r.pool.Exec(ctx, query, name, prefix, hash, scopeStrings(scopes)); err != nil {
+		return errors.Wrapf(err, "error ensuring api key %s", name)
+	}
+	return nil
+}
+
+// RotateKey revokes the key with id and creates its replacement, with the same name and scopes,
+// in one transaction. Unknown or already revoked keys return ErrInvalidArgument.
+func (r *repository) RotateKey(ctx context.Context, id string, prefix string, hash string) (APIKey, error) {
+	var key APIKey
+	err := r.pool.BeginFunc(ctx, func(tx pgx.Tx) error {
+		revoked, err := revokeKey(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+
+		query := `
+		    INSERT INTO api_keys (name, prefix, key_hash, scopes, rotated_from)
+		    VALUES
+		    ($1, $2, $3, $4, $5)
+		    RETURNING ` + columns
+		key, err = scanKey(tx.QueryRow(ctx, query, revoked.Name, prefix, hash, scopeStrings(revoked.Scopes), revoked.ID))
+		return err
+	})
+	return key, err
+}
+
+// RevokeKey revokes the key with id. Unknown or already revoked keys return ErrInvalidArgument.
+func (r *repository) RevokeKey(ctx context.Context, id string) (APIKey, error) {
+	return revokeKey(ctx, r.pool, id)
+}
+
+type queryRower interface {
+	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
+}
+
+func revokeKey(ctx context.Context, db queryRower, id string) (APIKey, error) {
+	query := `
+	    UPDATE api_keys
+	    SET revoked_at = now()
+	    WHERE id = $1 AND revoked_at IS NULL
+	    RETURNING ` + columns
+	key, err := scanKey(db.QueryRow(ctx, query, id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return APIKey{}, errors.Wrapf(ErrInvalidArgument, "no active api key with id %s", id)
+	}
+	return key, err
+}
+
+func scanKey(row pgx.Row) (APIKey, error) {
+	var key APIKey
+	var scopes []string
+	if err := row.Scan(
+		&key.ID,
+		&key.Name,
+		&key.Prefix,
+		&scopes,
+		&key.CreatedAt,
+		&key.RotatedFrom,
+		&key.RevokedAt,
+	); err != nil {
+		return APIKey{}, errors.Wrap(err, "error scanning api key from database")
+	}
+	key.Scopes = lo.Map(scopes, func(scope string, _ int) auth.Scope {
+		return auth.Scope(scope)
+	})
+	return key, nil
+}
+
+func scopeStrings(scopes []auth.Scope) []string {
+	return lo.Map(scopes, func(scope auth.Scope, _ int) string {
+		return string(scope)
+	})
+}
@@ -0,0 +1,133 @@
+package apikeys
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"github.com/pkg/errors"
+	"github.com/rchauhan9/sportech/auth"
+	"strings"
+)
+
+var ErrInvalidArgument = errors.New("invalid argument")
+
+// keyPrefix starts every key so leaked keys are easy to recognise.
+const keyPrefix = "spt_"
+
+type Service interface {
+	ListKeys(ctx context.Context) ([]APIKey, error)
+	IssueKey(ctx context.Context, name string, scopes []auth.Scope) (IssuedKey, error)
+	RotateKey(ctx context.Context, id string) (IssuedKey, error)
+	RevokeKey(ctx context.Context, id string) (APIKey, error)
+	// EnsureKey stores key, which the caller chose, unless it is already stored. It is how the
+	// first admin key is created.
+	EnsureKey(ctx context.Context, name string, key string, scopes []auth.Scope) error
+	Authenticate(ctx context.Context, credential string) (auth.Principal, error)
+}
+
+func NewService(repository Repository) Service {
+	return &service{repository: repository}
+}
+
+type service struct {
+	repository Repository
+}
+
+func (s *service) ListKeys(ctx context.Context) ([]APIKey, error) {
+	return s.repository.ListKeys(ctx)
+}
+
+func (s *service) IssueKey(ctx context.Context, name string, scopes []auth.Scope) (IssuedKey, error) {
+	if err := validateKey(name, scopes); err != nil {
+		return IssuedKey{}, err
+	}
+
+	key, err := generateKey()
+	if err != nil {
+		return IssuedKey{}, err
+	}
+	apiKey, err := s.repository.CreateKey(ctx, strings.TrimSpace(name), prefix(key), hash(key), scopes)
+	if err != nil {
+		return IssuedKey{}, errors.Wrapf(err, "error issuing api key %s", name)
+	}
+	return IssuedKey{APIKey: apiKey, Key: key}, nil
+}
+
+// RotateKey replaces the key with id by a new one with the same name and scopes. The old key
+// stops working straight away.
+func (s *service) RotateKey(ctx context.Context, id string) (IssuedKey, error) {
+	key, err := generateKey()
+	if err != nil {
+		return IssuedKey{}, err
+	}
+	apiKey, err := s.repository.RotateKey(ctx, id, prefix(key), hash(key))
+	if err != nil {
+		return IssuedKey{}, errors.Wrapf(err, "error rotating api key with id %s", id)
+	}
+	return IssuedKey{APIKey: apiKey, Key: key}, nil
+}
+
+func (s *service) RevokeKey(ctx context.Context, id string) (APIKey, error) {
+	return s.repository.RevokeKey(ctx, id)
+}
+
+func (s *service) EnsureKey(ctx context.Context, name string, key string, scopes []auth.Scope) error {
+	if err := validateKey(name, scopes); err != nil {
+		return err
+	}
+	if len(key) < 32 {
+		return errors.Wrap(ErrInvalidArgument, "api keys must be at least 32 characters")
+	}
+	return s.repository.EnsureKey(ctx, name, prefix(key), hash(key), scopes)
+}
+
+func (s *service) Authenticate(ctx context.Context, credential string) (auth.Principal, error) {
+	if credential == "" {
+		return auth.Principal{}, errors.Wrap(auth.ErrUnauthenticated, "no api key given")
+	}
+	apiKey, err := s.repository.GetActiveKey(ctx, hash(credential))
+	if err != nil {
+		return auth.Principal{}, err
+	}
+	return auth.Principal{Subject: "apikey:" + apiKey.ID, Scopes: apiKey.Scopes}, nil
+}
+
+func validateKey(name string, scopes []auth.Scope) error {
+	if strings.TrimSpace(name) == "" {
+		return errors.Wrap(ErrInvalidArgument, "name is required")
+	}
+	if len(scopes) == 0 {
+		return errors.Wrap(ErrInvalidArgument, "at least one scope is required")
+	}
+	for _, scope := range scopes {
+		if !scope.Valid() {
+			return errors.Wrapf(ErrInvalidArgument, "unknown scope %q", scope)
+		}
+	}
+	return nil
+}
+
+// generateKey returns keyPrefix followed by 32 random bytes, base64url encoded.
+func generateKey() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", errors.Wrap(err, "error generating api key")
+	}
+	return keyPrefix + base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// hash is what is stored in place of key. Keys are random enough that a plain SHA-256 cannot be
+// reversed, and unlike a salted hash it can be looked up directly.
+func hash(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+func prefix(key string) string {
+	if len(key) < 12 {
+		return key
+	}
+	return key[:12]
+}
@@ -0,0 +1,122 @@
+package auth
+
+import (
+	"context"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+	"github.com/pkg/errors"
+	"github.com/rchauhan9/sportech/commons/go/logging"
+	"github.com/samber/lo"
+	"reflect"
+	"strings"
+)
+
+var (
+	ErrUnauthenticated = errors.New("unauthenticated")
+	ErrForbidden       = errors.New("forbidden")
+)
+
+// Scope is a permission granted to a caller.
+type Scope string
+
+const (
+	ScopeReadPublic  Scope = "read:public"
+	ScopeWriteSquads Scope = "write:squads"
+	// ScopeAdmin grants every other scope as well.
+	ScopeAdmin Scope = "admin"
+)
+
+var Scopes = []Scope{ScopeReadPublic, ScopeWriteSquads, ScopeAdmin}
+
+func (s Scope) Valid() bool {
+	return lo.Contains(Scopes, s)
+}
+
+// Principal is who a request was made by and what they may do. Roles and Claims are only set for
+// bearer tokens, and are kept so that endpoints and audit logs can see them.
+type Principal struct {
+	Subject string
+	Scopes  []Scope
+	Roles   []string
+	Claims  map[string]interface{}
+}
+
+func (p Principal) HasScope(scope Scope) bool {
+	return lo.Contains(p.Scopes, scope) || lo.Contains(p.Scopes, ScopeAdmin)
+}
+
+func (p Principal) HasRole(role string) bool {
+	return lo.Contains(p.Roles, role)
+}
+
+// Authenticator resolves the credential a request was made with into a principal. It returns
+// ErrUnauthenticated for credentials it does not recognise.
+type Authenticator interface {
+	Authenticate(ctx context.Context, credential string) (Principal, error)
+}
+
+// NewAnonymous lets requests made without a credential through as a principal holding scopes.
+// Requests with a credential are passed to next.
+func NewAnonymous(next Authenticator, scopes []Scope) Authenticator {
+	return &anonymous{next: next, scopes: scopes}
+}
+
+type anonymous struct {
+	next   Authenticator
+	scopes []Scope
+}
+
+func (a *anonymous) Authenticate(ctx context.Context, credential string) (Principal, error) {
+	if credential == "" {
+		return Principal{Subject: "anonymous", Scopes: a.scopes}, nil
+	}
+	return a.next.Authenticate(ctx, credential)
+}
+
+// Authorize finds the principal for the credential in ctx, failing with ErrUnauthenticated if
+// it is not recognised and ErrForbidden if the principal lacks scope.
+func Authorize(ctx context.Context, authenticator Authenticator, scope Scope) (Principal, error) {
+	principal, err := authenticator.Authenticate(ctx, CredentialFromContext(ctx))
+	if err != nil {
+		return Principal{}, err
+	}
+	if !principal.HasScope(scope) {
+		return Principal{}, errors.Wrapf(ErrForbidden, "%s requires scope %s", principal.Subject, scope)
+	}
+	return principal, nil
+}
+
+// Middleware rejects requests that Authorize does not allow. The principal is added to the
+// context for the endpoint.
+func Middleware(authenticator Authenticator, scope Scope) endpoint.Middleware {
+	return func(next endpoint.Endpoint) endpoint.Endpoint {
+		return func(ctx context.Context, request interface{}) (interface{}, error) {
+			principal, err := Authorize(ctx, authenticator, scope)
+			if err != nil {
+				return nil, err
+			}
+			return next(WithPrincipal(ctx, principal), request)
+		}
+	}
+}
+
+// AuditMiddleware logs who made each request that was made with a credential, along with the
+// issuer and roles of bearer tokens. It must run inside Middleware, which finds the principal.
+func AuditMiddleware(logger log.Logger) endpoint.Middleware {
+	return func(next endpoint.Endpoint) endpoint.Endpoint {
+		return func(ctx context.Context, request interface{}) (interface{}, error) {
+			if principal, ok := PrincipalFromContext(ctx); ok && CredentialFromContext(ctx) != "" {
+				keyvals := []interface{}{"msg", "authenticated", "request", reflect.TypeOf(request), "subject", principal.Subject}
+				if issuer, ok := principal.Claims["iss"]; ok {
+					keyvals = append(keyvals, "issuer", issuer)
+				}
+				if len(principal.Roles) > 0 {
+					keyvals = append(keyvals, "roles", strings.Join(principal.Roles, " "))
+				}
+				level.Info(logging.FromContext(ctx, logger)).Log(keyvals...)
+			}
+			return next(ctx, request)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package auth
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type fakeAuthenticator map[string]Principal
+
+func (f fakeAuthenticator) Authenticate(_ context.Context, credential string) (Principal, error) {
+	principal, ok := f[credential]
+	if !ok {
+		return Principal{}, ErrUnauthenticated
+	}
+	return principal, nil
+}
+
+func TestMiddleware(t *testing.T) {
+	authenticator := NewAnonymous(fakeAuthenticator{
+		"reader": {Subject: "reader", Scopes: []Scope{ScopeReadPublic}},
+		"admin":  {Subject: "admin", Scopes: []Scope{ScopeAdmin}},
+	}, []Scope{ScopeReadPublic})
+
+	var seen Principal
+	endpoint := func(ctx context.Context, _ interface{}) (interface{}, error) {
+		seen, _ = PrincipalFromContext(ctx)
+		return "ok", nil
+	}
+
+	cases := []struct {
+		credential string
+		scope      Scope
+		err        error
+		subject    string
+	}{
+		{credential: "", scope: ScopeReadPublic, subject: "anonymous"},
+		{credential: "", scope: ScopeWriteSquads, err: ErrForbidden},
+		{credential: "unknown", scope: ScopeReadPublic, err: ErrUnauthenticated},
+		{credential: "reader", scope: ScopeReadPublic, subject: "reader"},
+		{credential: "reader", scope: ScopeAdmin, err: ErrForbidden},
+		{credential: "admin", scope: ScopeWriteSquads, subject: "admin"},
+	}
+	for _, c := range cases {
+		seen = Principal{}
+		ctx := WithCredential(context.Background(), c.credential)
+		_, err := Middleware(authenticator, c.scope)(endpoint)(ctx, nil)
+		if c.err != nil {
+			require.Equal(t, c.err, errors.Cause(err), "%q with %s", c.credential, c.scope)
+			continue
+		}
+		require.NoError(t, err)
+		require.Equal(t, c.subject, seen.Subject)
+	}
+}
+
+func TestHTTPToContext(t *testing.T) {
+	var credential string
+	handler := HTTPToContext(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		credential = CredentialFromContext(r.Context())
+	}))
+
+	cases := map[string]http.Header{
+		"bearer": {"Authorization": []string{"Bearer bearer"}},
+		"key":    {"X-Api-Key": []string{"key"}},
+		"":       {"Authorization": []string{"Basic dXNlcjpwYXNz"}},
+	}
+	for expected, header := range cases {
+		r := httptest.NewRequest(http.MethodGet, "/teams/", nil)
+		r.Header = header
+		handler.ServeHTTP(httptest.NewRecorder(), r)
+		require.Equal(t, expected, credential)
+	}
+}
@@ -0,0 +1,30 @@
+package auth
+
+import "context"
+
+type contextKey int
+
+const (
+	credentialKey contextKey = iota
+	principalKey
+)
+
+func WithCredential(ctx context.Context, credential string) context.Context {
+	return context.WithValue(ctx, credentialKey, credential)
+}
+
+// CredentialFromContext returns the credential the request was made with, or "" if it had none.
+func CredentialFromContext(ctx context.Context) string {
+	credential, _ := ctx.Value(credentialKey).(string)
+	return credential
+}
+
+func WithPrincipal(ctx context.Context, principal Principal) context.Context {
+	return context.WithValue(ctx, principalKey, principal)
+}
+
+// PrincipalFromContext returns the principal Middleware authenticated, if any.
+func PrincipalFromContext(ctx context.Context) (Principal, bool) {
+	principal, ok := ctx.Value(principalKey).(Principal)
+	return principal, ok
+}
@@ -0,0 +1,106 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/go-jose/go-jose/v3"
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+	"github.com/pkg/errors"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+)
+
+// KeySet holds the public keys bearer tokens are verified against, as loaded from a JWKS
+// document. Keys loaded from a URL can be kept fresh with Run.
+type KeySet struct {
+	mu     sync.RWMutex
+	keys   jose.JSONWebKeySet
+	source string
+	load   func(ctx context.Context) ([]byte, error)
+}
+
+// NewFileKeySet loads the JWKS document at path, which is how tests and offline deployments
+// supply keys.
+func NewFileKeySet(path string) (*KeySet, error) {
+	k := &KeySet{source: path, load: func(context.Context) ([]byte, error) {
+		return os.ReadFile(path)
+	}}
+	return k, k.Refresh(context.Background())
+}
+
+// NewURLKeySet fetches the JWKS document served at url, such as an identity provider's
+// jwks_uri.
+func NewURLKeySet(ctx context.Context, url string, client *http.Client) (*KeySet, error) {
+	k := &KeySet{source: url, load: func(ctx context.Context) ([]byte, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return nil, errors.Errorf("unexpected status %s", resp.Status)
+		}
+		return io.ReadAll(resp.Body)
+	}}
+	return k, k.Refresh(ctx)
+}
+
+// Refresh reloads the keys. The keys already held are kept if loading fails.
+func (k *KeySet) Refresh(ctx context.Context) error {
+	body, err := k.load(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "error loading jwks from %s", k.source)
+	}
+	var keys jose.JSONWebKeySet
+	if err := json.Unmarshal(body, &keys); err != nil {
+		return errors.Wrapf(err, "error parsing jwks from %s", k.source)
+	}
+	for _, key := range keys.Keys {
+		if !key.IsPublic() {
+			return errors.Errorf("jwks from %s holds a private key %q", k.source, key.KeyID)
+		}
+	}
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	k.keys = keys
+	return nil
+}
+
+// Run refreshes the keys every interval until ctx is cancelled, so keys the identity provider
+// rotates in are picked up.
+func (k *KeySet) Run(ctx context.Context, interval time.Duration, logger log.Logger) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := k.Refresh(ctx); err != nil {
+				level.Error(logger).Log("err", err)
+			}
+		}
+	}
+}
+
+// Key returns the key with id kid. A token without a kid may use the only key in the set.
+func (k *KeySet) Key(kid string) (jose.JSONWebKey, bool) {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	if kid == "" && len(k.keys.Keys) == 1 {
+		return k.keys.Keys[0], true
+	}
+	if keys := k.keys.Key(kid); len(keys) > 0 && kid != "" {
+		return keys[0], true
+	}
+	return jose.JSONWebKey{}, false
+}
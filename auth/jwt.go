@@ -0,0 +1,119 @@
+package auth
+
+import (
+	"context"
+	"github.com/go-jose/go-jose/v3/jwt"
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+	"strings"
+	"time"
+)
+
+// JWTOptions are what a bearer token must carry to be accepted, and how its roles become scopes.
+type JWTOptions struct {
+	Issuer   string
+	Audience string
+	// RolesClaim names the claim holding the caller's roles, as a list or a space-separated
+	// string. A dotted name such as "realm_access.roles" reaches into nested claims.
+	RolesClaim string
+	// Roles maps each role to the scopes it grants, matching role names case-insensitively. Roles
+	// not listed grant nothing.
+	Roles map[string][]Scope
+	// Leeway allows for clock skew when checking exp, nbf and iat.
+	Leeway time.Duration
+}
+
+// NewJWTAuthenticator accepts signed JWTs verified against keys, with an expiry, and with the
+// issuer and audience in options. Credentials that are not JWTs, such as API keys, are passed to
+// next.
+func NewJWTAuthenticator(next Authenticator, keys *KeySet, options JWTOptions) (Authenticator, error) {
+	if options.Issuer == "" || options.Audience == "" {
+		return nil, errors.New("bearer tokens need an issuer and audience to check")
+	}
+	options.Roles = lo.MapKeys(options.Roles, func(_ []Scope, role string) string {
+		return strings.ToLower(role)
+	})
+	return &jwtAuthenticator{next: next, keys: keys, options: options, now: time.Now}, nil
+}
+
+type jwtAuthenticator struct {
+	next    Authenticator
+	keys    *KeySet
+	options JWTOptions
+	now     func() time.Time
+}
+
+func (a *jwtAuthenticator) Authenticate(ctx context.Context, credential string) (Principal, error) {
+	if strings.Count(credential, ".") != 2 {
+		return a.next.Authenticate(ctx, credential)
+	}
+
+	token, err := jwt.ParseSigned(credential)
+	if err != nil {
+		return Principal{}, errors.Wrap(ErrUnauthenticated, "malformed bearer token")
+	}
+	header := token.Headers[0]
+	key, ok := a.keys.Key(header.KeyID)
+	if !ok {
+		return Principal{}, errors.Wrapf(ErrUnauthenticated, "bearer token signed with unknown key %q", header.KeyID)
+	}
+	if key.Algorithm != "" && key.Algorithm != header.Algorithm {
+		return Principal{}, errors.Wrapf(ErrUnauthenticated, "bearer token signed with %s, not %s", header.Algorithm, key.Algorithm)
+	}
+
+	var claims jwt.Claims
+	var raw map[string]interface{}
+	if err := token.Claims(key, &claims, &raw); err != nil {
+		return Principal{}, errors.Wrap(ErrUnauthenticated, "bearer token signature is invalid")
+	}
+	if claims.Expiry == nil {
+		return Principal{}, errors.Wrap(ErrUnauthenticated, "bearer token has no expiry")
+	}
+	expected := jwt.Expected{
+		Issuer:   a.options.Issuer,
+		Audience: jwt.Audience{a.options.Audience},
+		Time:     a.now(),
+	}
+	if err := claims.ValidateWithLeeway(expected, a.options.Leeway); err != nil {
+		return Principal{}, errors.Wrapf(ErrUnauthenticated, "bearer token rejected: %s", err)
+	}
+
+	roles := roles(raw, a.options.RolesClaim)
+	var scopes []Scope
+	for _, role := range roles {
+		scopes = append(scopes, a.options.Roles[strings.ToLower(role)]...)
+	}
+	return Principal{
+		Subject: claims.Subject,
+		Scopes:  lo.Uniq(scopes),
+		Roles:   roles,
+		Claims:  raw,
+	}, nil
+}
+
+// roles reads the claim at the dotted path name.
+func roles(claims map[string]interface{}, name string) []string {
+	var value interface{} = claims
+	for _, part := range strings.Split(name, ".") {
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		value = object[part]
+	}
+
+	switch v := value.(type) {
+	case string:
+		return strings.Fields(v)
+	case []interface{}:
+		var roles []string
+		for _, role := range v {
+			if s, ok := role.(string); ok {
+				roles = append(roles, s)
+			}
+		}
+		return roles
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,134 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"github.com/go-jose/go-jose/v3"
+	"github.com/go-jose/go-jose/v3/jwt"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+const (
+	testIssuer   = "https://id.example.com/"
+	testAudience = "sportech"
+)
+
+// testKey generates an RSA key with id kid, returning the private key for signing and the JWKS
+// document holding its public half.
+func testKey(t *testing.T, kid string) (jose.JSONWebKey, []byte) {
+	private, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	key := jose.JSONWebKey{Key: private, KeyID: kid, Algorithm: string(jose.RS256), Use: "sig"}
+	jwks, err := json.Marshal(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{key.Public()}})
+	require.NoError(t, err)
+	return key, jwks
+}
+
+func sign(t *testing.T, key jose.JSONWebKey, claims ...interface{}) string {
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.RS256, Key: key}, (&jose.SignerOptions{}).WithType("JWT"))
+	require.NoError(t, err)
+	builder := jwt.Signed(signer)
+	for _, c := range claims {
+		builder = builder.Claims(c)
+	}
+	token, err := builder.CompactSerialize()
+	require.NoError(t, err)
+	return token
+}
+
+func TestJWTAuthenticator(t *testing.T) {
+	key, jwks := testKey(t, "current")
+	otherKey, _ := testKey(t, "current")
+	unknownKey, _ := testKey(t, "unknown")
+
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	require.NoError(t, os.WriteFile(path, jwks, 0o600))
+	keySet, err := NewFileKeySet(path)
+	require.NoError(t, err)
+
+	apiKeys := fakeAuthenticator{"spt_key": {Subject: "apikey:1", Scopes: []Scope{ScopeReadPublic}}}
+	authenticator, err := NewJWTAuthenticator(apiKeys, keySet, JWTOptions{
+		Issuer:     testIssuer,
+		Audience:   testAudience,
+		RolesClaim: "realm_access.roles",
+		Roles:      map[string][]Scope{"editor": {ScopeReadPublic, ScopeWriteSquads}},
+	})
+	require.NoError(t, err)
+
+	now := time.Now()
+	valid := jwt.Claims{
+		Subject:  "user-1",
+		Issuer:   testIssuer,
+		Audience: jwt.Audience{testAudience, "other"},
+		Expiry:   jwt.NewNumericDate(now.Add(time.Hour)),
+		IssuedAt: jwt.NewNumericDate(now),
+	}
+	roles := map[string]interface{}{"realm_access": map[string]interface{}{"roles": []string{"Editor", "viewer"}}}
+
+	principal, err := authenticator.Authenticate(context.Background(), sign(t, key, valid, roles))
+	require.NoError(t, err)
+	require.Equal(t, "user-1", principal.Subject)
+	require.Equal(t, []string{"Editor", "viewer"}, principal.Roles)
+	require.True(t, principal.HasRole("viewer"))
+	require.Equal(t, []Scope{ScopeReadPublic, ScopeWriteSquads}, principal.Scopes)
+	require.Equal(t, testIssuer, principal.Claims["iss"])
+
+	principal, err = authenticator.Authenticate(context.Background(), "spt_key")
+	require.NoError(t, err)
+	require.Equal(t, "apikey:1", principal.Subject)
+
+	expired := valid
+	expired.Expiry = jwt.NewNumericDate(now.Add(-time.Hour))
+	noExpiry := valid
+	noExpiry.Expiry = nil
+	wrongAudience := valid
+	wrongAudience.Audience = jwt.Audience{"someone-else"}
+	wrongIssuer := valid
+	wrongIssuer.Issuer = "https://evil.example.com/"
+
+	rejected := map[string]string{
+		"expired":        sign(t, key, expired),
+		"no expiry":      sign(t, key, noExpiry),
+		"wrong audience": sign(t, key, wrongAudience),
+		"wrong issuer":   sign(t, key, wrongIssuer),
+		"forged":         sign(t, otherKey, valid),
+		"unknown key":    sign(t, unknownKey, valid),
+		"malformed":      "not.a.jwt",
+	}
+	for name, token := range rejected {
+		_, err := authenticator.Authenticate(context.Background(), token)
+		require.Equal(t, ErrUnauthenticated, errors.Cause(err), name)
+	}
+}
+
+func TestURLKeySet(t *testing.T) {
+	key, jwks := testKey(t, "first")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Write(jwks)
+	}))
+	defer server.Close()
+
+	keySet, err := NewURLKeySet(context.Background(), server.URL, server.Client())
+	require.NoError(t, err)
+	found, ok := keySet.Key("first")
+	require.True(t, ok)
+	require.Equal(t, key.Public().Key, found.Key)
+
+	rotated, rotatedJWKS := testKey(t, "second")
+	jwks = rotatedJWKS
+	require.NoError(t, keySet.Refresh(context.Background()))
+	_, ok = keySet.Key("first")
+	require.False(t, ok)
+	found, ok = keySet.Key("second")
+	require.True(t, ok)
+	require.Equal(t, rotated.Public().Key, found.Key)
+}
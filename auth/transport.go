@@ -0,0 +1,45 @@
+package auth
+
+import (
+	"context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"net/http"
+	"strings"
+)
+
+// HTTPToContext reads the request's credential from an "Authorization: Bearer" or X-API-Key
+// header into its context.
+func HTTPToContext(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if credential := credential(r.Header.Get("Authorization"), r.Header.Get("X-API-Key")); credential != "" {
+			r = r.WithContext(WithCredential(r.Context(), credential))
+		}
+		h.ServeHTTP(w, r)
+	})
+}
+
+// UnaryServerInterceptor does for gRPC what HTTPToContext does for HTTP, reading the
+// authorization or x-api-key metadata.
+func UnaryServerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if credential := credential(first(md.Get("authorization")), first(md.Get("x-api-key"))); credential != "" {
+			ctx = WithCredential(ctx, credential)
+		}
+	}
+	return handler(ctx, req)
+}
+
+func credential(authorization string, apiKey string) string {
+	if scheme, token, ok := strings.Cut(authorization, " "); ok && strings.EqualFold(scheme, "Bearer") {
+		return strings.TrimSpace(token)
+	}
+	return strings.TrimSpace(apiKey)
+}
+
+func first(values []string) string {
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
@@ -0,0 +1,112 @@
+package cache
+
+import (
+	"context"
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/pkg/errors"
+	"sync"
+	"time"
+)
+
+// Channel is the Postgres notification channel the table_versions triggers notify with the
+// name of the changed table.
+const Channel = "table_changed"
+
+const (
+	minBackoff = time.Second
+	maxBackoff = 30 * time.Second
+)
+
+// Listener relays table change notifications to subscribers, so every instance drops entries
+// cached from a table as soon as any instance writes to it.
+type Listener struct {
+	pool   *pgxpool.Pool
+	logger log.Logger
+
+	mu          sync.Mutex
+	subscribers map[string][]func()
+	all         []func()
+}
+
+func NewListener(pool *pgxpool.Pool, logger log.Logger) *Listener {
+	return &Listener{pool: pool, logger: logger, subscribers: map[string][]func(){}}
+}
+
+// Subscribe calls invalidate whenever one of tables changes, and whenever the listener
+// reconnects, since notifications sent while it was disconnected are lost.
+//
+// tables must name every table the cached values are read from, including those joined in from
+// other packages, such as the persons tables behind players. Each package exports its list as
+// Tables, which httpcache.Resource uses in the same way; a table left out keeps serving stale
+// values until they expire.
+func (l *Listener) Subscribe(tables []string, invalidate func()) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, table := range tables {
+		l.subscribers[table] = append(l.subscribers[table], invalidate)
+	}
+	l.all = append(l.all, invalidate)
+}
+
+// Run listens until ctx is done, reconnecting with backoff when the connection drops.
+func (l *Listener) Run(ctx context.Context) {
+	backoff := minBackoff
+	for {
+		err := l.listen(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		level.Error(l.logger).Log("msg", "lost cache invalidation listener", "err", err, "retry", backoff)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+		if backoff *= 2; backoff > maxBackoff {
+			backoff = maxBackoff
+		}
+	}
+}
+
+func (l *Listener) listen(ctx context.Context) error {
+	// LISTEN holds a session open, so use a connection of its own rather than one of the pool's.
+	conn, err := pgx.ConnectConfig(ctx, l.pool.Config().ConnConfig)
+	if err != nil {
+		return errors.Wrap(err, "error connecting to database")
+	}
+	defer conn.Close(context.Background())
+
+	if _, err := conn.Exec(ctx, "LISTEN "+Channel); err != nil {
+		return errors.Wrapf(err, "error listening on %s", Channel)
+	}
+	l.invalidateAll()
+
+	for {
+		notification, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return errors.Wrap(err, "error waiting for notification")
+		}
+		l.invalidate(notification.Payload)
+	}
+}
+
+func (l *Listener) invalidate(table string) {
+	l.mu.Lock()
+	subscribers := l.subscribers[table]
+	l.mu.Unlock()
+	for _, invalidate := range subscribers {
+		invalidate()
+	}
+}
+
+func (l *Listener) invalidateAll() {
+	l.mu.Lock()
+	all := l.all
+	l.mu.Unlock()
+	for _, invalidate := range all {
+		invalidate()
+	}
+}
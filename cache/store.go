@@ -0,0 +1,182 @@
+package cache
+
+import (
+	"container/list"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Store is an in-memory LRU of loaded values that expire after a TTL. Its size is bounded by
+// cost rather than entries: a list costs one per item, so caching every person counts for as
+// much as caching that many single lookups.
+type Store struct {
+	mu         sync.Mutex
+	ttl        time.Duration
+	maxCost    int
+	cost       int
+	entries    map[string]*list.Element
+	order      *list.List
+	generation uint64
+	now        func() time.Time
+}
+
+type entry struct {
+	key     string
+	value   interface{}
+	cost    int
+	expires time.Time
+}
+
+func NewStore(ttl time.Duration, maxCost int) *Store {
+	return &Store{
+		ttl:     ttl,
+		maxCost: maxCost,
+		entries: map[string]*list.Element{},
+		order:   list.New(),
+		now:     time.Now,
+	}
+}
+
+// Load returns the value cached under key, calling load and caching its result on a miss.
+// Errors are returned without being cached.
+func Load[T any](s *Store, key string, load func() (T, error)) (T, error) {
+	if value, ok := s.get(key); ok {
+		return value.(T), nil
+	}
+
+	generation := s.currentGeneration()
+	value, err := load()
+	if err != nil {
+		return value, err
+	}
+	s.set(key, value, costOf(value), generation)
+	return value, nil
+}
+
+// LoadEach is Load for batched lookups: each ID is cached on its own under Key(method, id), and
+// load is called once with just the IDs that missed. IDs load leaves out of its result are not
+// cached, so they are asked for again next time.
+func LoadEach[T any](s *Store, method string, ids []string, load func(missing []string) (map[string]T, error)) (map[string]T, error) {
+	values := make(map[string]T, len(ids))
+	var missing []string
+	for _, id := range ids {
+		if value, ok := s.get(Key(method, id)); ok {
+			values[id] = value.(T)
+		} else {
+			missing = append(missing, id)
+		}
+	}
+	if len(missing) == 0 {
+		return values, nil
+	}
+
+	generation := s.currentGeneration()
+	loaded, err := load(missing)
+	if err != nil {
+		return nil, err
+	}
+	for id, value := range loaded {
+		s.set(Key(method, id), value, costOf(value), generation)
+		values[id] = value
+	}
+	return values, nil
+}
+
+// Purge drops every entry. Loads already in flight are not cached when they finish, as they may
+// have read the data that changed.
+func (s *Store) Purge() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entries = map[string]*list.Element{}
+	s.order.Init()
+	s.cost = 0
+	s.generation++
+}
+
+// Len is the number of entries held, including any that have expired but not been evicted yet.
+func (s *Store) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.order.Len()
+}
+
+func (s *Store) currentGeneration() uint64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.generation
+}
+
+func (s *Store) get(key string) (interface{}, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	element, ok := s.entries[key]
+	if !ok {
+		return nil, false
+	}
+	e := element.Value.(*entry)
+	if !s.now().Before(e.expires) {
+		s.remove(element)
+		return nil, false
+	}
+	s.order.MoveToFront(element)
+	return e.value, true
+}
+
+func (s *Store) set(key string, value interface{}, cost int, generation uint64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if generation != s.generation || cost > s.maxCost {
+		return
+	}
+	if element, ok := s.entries[key]; ok {
+		s.remove(element)
+	}
+	for s.cost+cost > s.maxCost {
+		s.remove(s.order.Back())
+	}
+	s.entries[key] = s.order.PushFront(&entry{key: key, value: value, cost: cost, expires: s.now().Add(s.ttl)})
+	s.cost += cost
+}
+
+func (s *Store) remove(element *list.Element) {
+	e := s.order.Remove(element).(*entry)
+	delete(s.entries, e.key)
+	s.cost -= e.cost
+}
+
+// costOf counts a slice as its length plus one and anything else as one.
+func costOf(value interface{}) int {
+	v := reflect.ValueOf(value)
+	if v.Kind() == reflect.Slice {
+		return v.Len() + 1
+	}
+	return 1
+}
+
+// Key builds a cache key from a method name and its arguments. Pointers are dereferenced so
+// equal values share a key.
+func Key(method string, args ...interface{}) string {
+	parts := make([]string, 0, len(args)+1)
+	parts = append(parts, method)
+	for _, arg := range args {
+		v := reflect.ValueOf(arg)
+		for v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				break
+			}
+			v = v.Elem()
+		}
+		switch {
+		case !v.IsValid(), v.Kind() == reflect.Ptr:
+			parts = append(parts, "<nil>")
+		case v.Type() == reflect.TypeOf(time.Time{}):
+			parts = append(parts, v.Interface().(time.Time).UTC().Format(time.RFC3339Nano))
+		default:
+			parts = append(parts, fmt.Sprintf("%v", v.Interface()))
+		}
+	}
+	return strings.Join(parts, "\x00")
+}
@@ -0,0 +1,134 @@
+package cache
+
+import (
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestLoadCachesValues(t *testing.T) {
+	store := NewStore(time.Minute, 100)
+	loads := 0
+	load := func() ([]string, error) {
+		loads++
+		return []string{"arsenal", "spurs"}, nil
+	}
+
+	for i := 0; i < 3; i++ {
+		teams, err := Load(store, Key("ListTeams"), load)
+		require.NoError(t, err)
+		require.Equal(t, []string{"arsenal", "spurs"}, teams)
+	}
+	require.Equal(t, 1, loads)
+}
+
+func TestLoadDoesNotCacheErrors(t *testing.T) {
+	store := NewStore(time.Minute, 100)
+	loads := 0
+	load := func() (string, error) {
+		loads++
+		return "", errors.New("connection refused")
+	}
+
+	_, err := Load(store, Key("GetTeam", "arsenal"), load)
+	require.Error(t, err)
+	_, err = Load(store, Key("GetTeam", "arsenal"), load)
+	require.Error(t, err)
+	require.Equal(t, 2, loads)
+	require.Equal(t, 0, store.Len())
+}
+
+func TestEntriesExpire(t *testing.T) {
+	now := time.Date(2022, time.March, 1, 12, 0, 0, 0, time.UTC)
+	store := NewStore(time.Minute, 100)
+	store.now = func() time.Time { return now }
+	loads := 0
+	load := func() (int, error) {
+		loads++
+		return loads, nil
+	}
+
+	value, _ := Load(store, "key", load)
+	require.Equal(t, 1, value)
+	now = now.Add(59 * time.Second)
+	value, _ = Load(store, "key", load)
+	require.Equal(t, 1, value)
+	now = now.Add(time.Second)
+	value, _ = Load(store, "key", load)
+	require.Equal(t, 2, value)
+}
+
+func TestLeastRecentlyUsedIsEvictedByCost(t *testing.T) {
+	store := NewStore(time.Minute, 6)
+	list := func(n int) func() ([]int, error) {
+		return func() ([]int, error) { return make([]int, n), nil }
+	}
+
+	Load(store, "a", list(1)) // costs 2
+	Load(store, "b", list(1)) // costs 2
+	Load(store, "a", list(1)) // a is now the most recently used
+	Load(store, "c", list(2)) // costs 3, so b is evicted
+	require.Equal(t, 2, store.Len())
+
+	_, ok := store.get("b")
+	require.False(t, ok)
+	_, ok = store.get("a")
+	require.True(t, ok)
+
+	// Anything costing more than the whole store is never cached.
+	Load(store, "d", list(10))
+	_, ok = store.get("d")
+	require.False(t, ok)
+}
+
+func TestLoadEachOnlyLoadsMisses(t *testing.T) {
+	store := NewStore(time.Minute, 100)
+	var asked [][]string
+	load := func(missing []string) (map[string]string, error) {
+		asked = append(asked, missing)
+		values := map[string]string{}
+		for _, id := range missing {
+			if id != "unknown" {
+				values[id] = "value " + id
+			}
+		}
+		return values, nil
+	}
+
+	values, err := LoadEach(store, "Get", []string{"a", "b"}, load)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"a": "value a", "b": "value b"}, values)
+
+	values, err = LoadEach(store, "Get", []string{"b", "c", "unknown"}, load)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"b": "value b", "c": "value c"}, values)
+	require.Equal(t, [][]string{{"a", "b"}, {"c", "unknown"}}, asked)
+
+	cached, err := Load(store, Key("Get", "a"), func() (string, error) { return "", errors.New("not cached") })
+	require.NoError(t, err)
+	require.Equal(t, "value a", cached)
+}
+
+func TestPurgeDropsLoadsInFlight(t *testing.T) {
+	store := NewStore(time.Minute, 100)
+	Load(store, "a", func() (int, error) { return 1, nil })
+
+	Load(store, "b", func() (int, error) {
+		store.Purge()
+		return 2, nil
+	})
+	require.Equal(t, 0, store.Len())
+}
+
+func TestKey(t *testing.T) {
+	radius := 10.0
+	sameRadius := 10.0
+	require.Equal(t, Key("ListStadiumsNear", &radius), Key("ListStadiumsNear", &sameRadius))
+	require.NotEqual(t, Key("ListStadiumsNear", &radius), Key("ListStadiumsNear", (*float64)(nil)))
+	require.NotEqual(t, Key("GetTeam", "a", "bc"), Key("GetTeam", "ab", "c"))
+	require.Equal(t,
+		Key("ListTeams", time.Date(2022, time.March, 1, 0, 0, 0, 0, time.UTC)),
+		Key("ListTeams", time.Date(2022, time.March, 1, 0, 0, 0, 0, time.UTC).In(time.FixedZone("CET", 3600))),
+	)
+}
@@ -0,0 +1,19 @@
+package endpointutil
+
+import (
+	"reflect"
+	"strings"
+)
+
+// Name names the endpoint that handles request, turning a request of type
+// teams.listTeamsRequest into "teams.listTeams".
+func Name(request interface{}) string {
+	t := reflect.TypeOf(request)
+	if t == nil {
+		return "unknown"
+	}
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return strings.TrimSuffix(t.String(), "Request")
+}
@@ -0,0 +1,55 @@
+package logging
+
+import (
+	"context"
+
+	"github.com/go-kit/log"
+)
+
+type contextKey int
+
+const (
+	requestIDKey contextKey = iota
+	entryKey
+)
+
+// WithRequestID stores the ID of the request being served in ctx.
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey, id)
+}
+
+// RequestIDFromContext returns the ID stored by WithRequestID, or "" if there is none.
+func RequestIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey).(string)
+	return id
+}
+
+// FromContext adds the ID of the request being served in ctx, if any, to each line logger logs.
+func FromContext(ctx context.Context, logger log.Logger) log.Logger {
+	if id := RequestIDFromContext(ctx); id != "" {
+		return log.With(logger, "request_id", id)
+	}
+	return logger
+}
+
+// entry collects what the access log line written by MakeHandler reports about the request
+// beyond what the HTTP layer sees.
+type entry struct {
+	route string
+	err   error
+}
+
+// RecordError reports err in the access log line of the request being served in ctx. Each
+// package's encodeError calls it, so errors that never reached an endpoint, such as those
+// decoding the request, are logged with the request too.
+func RecordError(ctx context.Context, err error) {
+	if e, ok := ctx.Value(entryKey).(*entry); ok {
+		e.err = err
+	}
+}
+
+func recordRoute(ctx context.Context, route string) {
+	if e, ok := ctx.Value(entryKey).(*entry); ok {
+		e.route = route
+	}
+}
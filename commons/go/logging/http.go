@@ -0,0 +1,126 @@
+package logging
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+)
+
+const RequestIDHeader = "X-Request-ID"
+
+// MakeHandler wraps next, usually the whole mux, to give each request an ID and log a line once it
+// is served. The ID is taken from the request's X-Request-ID header, if it has a usable one, or
+// generated, then stored in the context and sent back in the response's X-Request-ID header.
+// clientKey identifies who made the request.
+func MakeHandler(next http.Handler, logger log.Logger, clientKey func(*http.Request) string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		startTime := time.Now()
+		id := r.Header.Get(RequestIDHeader)
+		if !validRequestID(id) {
+			id = newRequestID()
+		}
+		w.Header().Set(RequestIDHeader, id)
+
+		e := &entry{}
+		ctx := context.WithValue(WithRequestID(r.Context(), id), entryKey, e)
+		aw := &accessWriter{ResponseWriter: w}
+		served := false
+		// The line is written from a defer so that responses aborted with http.ErrAbortHandler,
+		// such as streams that fail part way through, are logged too.
+		defer func() {
+			if aw.status == 0 {
+				aw.status = http.StatusOK
+			}
+			route := e.route
+			if route == "" {
+				route = "none"
+			}
+			keyvals := []interface{}{
+				"request_id", id,
+				"method", r.Method,
+				"path", r.URL.Path,
+				"route", route,
+				"status", aw.status,
+				"bytes", aw.bytes,
+				"client", clientKey(r),
+				"time", time.Since(startTime),
+				"err", e.err,
+			}
+			leveled := level.Info(logger)
+			if !served {
+				leveled = level.Error(logger)
+				keyvals = append(keyvals, "aborted", true)
+			} else if aw.status >= http.StatusInternalServerError {
+				leveled = level.Error(logger)
+			}
+			leveled.Log(keyvals...)
+		}()
+		next.ServeHTTP(aw, r.WithContext(ctx))
+		served = true
+	})
+}
+
+// validRequestID accepts IDs of up to 128 printable ASCII characters, so that a caller cannot
+// break up log lines or fill them with junk.
+func validRequestID(id string) bool {
+	if id == "" || len(id) > 128 {
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		if id[i] <= ' ' || id[i] > '~' {
+			return false
+		}
+	}
+	return true
+}
+
+func newRequestID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return hex.EncodeToString(b)
+}
+
+type accessWriter struct {
+	http.ResponseWriter
+	status int
+	bytes  int
+}
+
+func (w *accessWriter) WriteHeader(status int) {
+	if w.status == 0 {
+		w.status = status
+	}
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *accessWriter) Write(b []byte) (int, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	n, err := w.ResponseWriter.Write(b)
+	w.bytes += n
+	return n, err
+}
+
+// Flush passes through to the underlying writer, so that streamed responses are not held back by
+// the access log.
+func (w *accessWriter) Flush() {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Unwrap lets http.ResponseController reach the underlying writer.
+func (w *accessWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
@@ -0,0 +1,120 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type getPlayerRequest struct{}
+
+func TestMakeHandlerLogsRequests(t *testing.T) {
+	var buf bytes.Buffer
+	logger, err := NewLogger("json", &buf)
+	require.NoError(t, err)
+
+	var e endpoint.Endpoint = func(ctx context.Context, request interface{}) (interface{}, error) {
+		return nil, errors.New("boom")
+	}
+	e = Middleware(logger)(e)
+	handler := MakeHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, err := e(r.Context(), getPlayerRequest{})
+		RecordError(r.Context(), err)
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(`{"error":"boom"}`))
+	}), logger, func(*http.Request) string { return "ip:192.0.2.1" })
+
+	r := httptest.NewRequest(http.MethodGet, "/players/1", nil)
+	r.Header.Set(RequestIDHeader, "abc-123")
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	require.Equal(t, "abc-123", w.Header().Get(RequestIDHeader))
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 2)
+	var endpointLine, accessLine map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &endpointLine))
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), &accessLine))
+
+	require.Equal(t, "abc-123", endpointLine["request_id"])
+	require.Equal(t, "boom", endpointLine["err"])
+	require.Equal(t, map[string]interface{}{
+		"level":      "error",
+		"request_id": "abc-123",
+		"method":     "GET",
+		"path":       "/players/1",
+		"route":      "logging.getPlayer",
+		"status":     float64(500),
+		"bytes":      float64(16),
+		"client":     "ip:192.0.2.1",
+		"time":       accessLine["time"],
+		"err":        "boom",
+	}, accessLine)
+}
+
+func TestMakeHandlerLogsAbortedRequests(t *testing.T) {
+	var buf bytes.Buffer
+	logger, err := NewLogger("json", &buf)
+	require.NoError(t, err)
+
+	handler := MakeHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"players":[`))
+		RecordError(r.Context(), errors.New("connection reset"))
+		panic(http.ErrAbortHandler)
+	}), logger, func(*http.Request) string { return "ip:192.0.2.1" })
+
+	require.PanicsWithValue(t, http.ErrAbortHandler, func() {
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/players/", nil))
+	})
+
+	var line map[string]interface{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &line))
+	require.Equal(t, "error", line["level"])
+	require.Equal(t, true, line["aborted"])
+	require.Equal(t, "connection reset", line["err"])
+	require.Equal(t, float64(200), line["status"])
+}
+
+func TestMakeHandlerReplacesUnusableRequestIDs(t *testing.T) {
+	logger, err := NewLogger("logfmt", &bytes.Buffer{})
+	require.NoError(t, err)
+	var seen string
+	handler := MakeHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen = RequestIDFromContext(r.Context())
+	}), logger, func(*http.Request) string { return "" })
+
+	for _, id := range []string{"", "has space", "line\nbreak", strings.Repeat("a", 129)} {
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r.Header.Set(RequestIDHeader, id)
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		require.Len(t, seen, 32)
+		require.NotEqual(t, id, seen)
+		require.Equal(t, seen, w.Header().Get(RequestIDHeader))
+	}
+}
+
+func TestMakeHandlerPassesFlushThrough(t *testing.T) {
+	logger, err := NewLogger("logfmt", &bytes.Buffer{})
+	require.NoError(t, err)
+	handler := MakeHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("{}\n"))
+		w.(http.Flusher).Flush()
+	}), logger, func(*http.Request) string { return "" })
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/players?format=ndjson", nil))
+	require.True(t, w.Flushed)
+}
+
+func TestNewLoggerRejectsUnknownFormats(t *testing.T) {
+	_, err := NewLogger("xml", &bytes.Buffer{})
+	require.Error(t, err)
+}
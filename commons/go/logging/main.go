@@ -2,20 +2,37 @@ package logging
 
 import (
 	"context"
+	"io"
 	"reflect"
 	"time"
 
 	"github.com/go-kit/kit/endpoint"
 	"github.com/go-kit/log"
 	"github.com/go-kit/log/level"
+	"github.com/pkg/errors"
+	"github.com/rchauhan9/sportech/commons/go/endpointutil"
 )
 
+// NewLogger writes to w in format, which is "logfmt" or "json".
+func NewLogger(format string, w io.Writer) (log.Logger, error) {
+	w = log.NewSyncWriter(w)
+	switch format {
+	case "logfmt", "":
+		return log.NewLogfmtLogger(w), nil
+	case "json":
+		return log.NewJSONLogger(w), nil
+	default:
+		return nil, errors.Errorf("unknown log format %q", format)
+	}
+}
+
 func Middleware(logger log.Logger) endpoint.Middleware {
 	return func(next endpoint.Endpoint) endpoint.Endpoint {
 		return func(ctx context.Context, request interface{}) (_ interface{}, err error) {
+			recordRoute(ctx, endpointutil.Name(request))
 			defer func(startTime time.Time) {
 				responseTime := time.Since(startTime)
-				level.Info(logger).Log("request", reflect.TypeOf(request), "time", responseTime, "err", err)
+				level.Info(FromContext(ctx, logger)).Log("request", reflect.TypeOf(request), "time", responseTime, "err", err)
 			}(time.Now())
 			return next(ctx, request)
 		}
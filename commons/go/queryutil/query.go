@@ -0,0 +1,42 @@
+package queryutil
+
+import (
+	"github.com/pkg/errors"
+	"github.com/rchauhan9/sportech/stream"
+	"net/http"
+	"time"
+)
+
+// The parsers below wrap their errors in invalid, the calling package's ErrInvalidArgument, so
+// that each package's encodeError answers them with a 400.
+
+// Today is the date used when a request does not ask for one.
+func Today() time.Time {
+	return time.Now().UTC().Truncate(24 * time.Hour)
+}
+
+// AsOf reads the optional asOf=YYYY-MM-DD query parameter, defaulting to Today.
+func AsOf(r *http.Request, invalid error) (time.Time, error) {
+	return Date("asOf", r.URL.Query().Get("asOf"), invalid)
+}
+
+// Date parses value, the YYYY-MM-DD date passed as name, defaulting to Today when it is empty.
+func Date(name string, value string, invalid error) (time.Time, error) {
+	if value == "" {
+		return Today(), nil
+	}
+	date, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return time.Time{}, errors.Wrapf(invalid, "invalid %s %q, expected YYYY-MM-DD", name, value)
+	}
+	return date, nil
+}
+
+// Format negotiates the format a list is written in from ?format= or the Accept header.
+func Format(r *http.Request, invalid error) (string, error) {
+	format, err := stream.Negotiate(r)
+	if err != nil {
+		return "", errors.Wrap(invalid, err.Error())
+	}
+	return format, nil
+}
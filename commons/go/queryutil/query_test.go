@@ -0,0 +1,35 @@
+package queryutil
+
+import (
+	"github.com/pkg/errors"
+	"github.com/rchauhan9/sportech/stream"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+var errInvalid = errors.New("invalid argument")
+
+func TestAsOf(t *testing.T) {
+	asOf, err := AsOf(httptest.NewRequest(http.MethodGet, "/teams/?asOf=2010-05-01", nil), errInvalid)
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2010, 5, 1, 0, 0, 0, 0, time.UTC), asOf)
+
+	asOf, err = AsOf(httptest.NewRequest(http.MethodGet, "/teams/", nil), errInvalid)
+	require.NoError(t, err)
+	require.Equal(t, Today(), asOf)
+
+	_, err = AsOf(httptest.NewRequest(http.MethodGet, "/teams/?asOf=01/05/2010", nil), errInvalid)
+	require.True(t, errors.Is(err, errInvalid))
+}
+
+func TestFormat(t *testing.T) {
+	format, err := Format(httptest.NewRequest(http.MethodGet, "/teams/?format=csv", nil), errInvalid)
+	require.NoError(t, err)
+	require.Equal(t, stream.FormatCSV, format)
+
+	_, err = Format(httptest.NewRequest(http.MethodGet, "/teams/?format=xml", nil), errInvalid)
+	require.True(t, errors.Is(err, errInvalid))
+}
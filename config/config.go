@@ -1,11 +1,134 @@
 package config
 
+import (
+	"github.com/rchauhan9/sportech/auth"
+	"github.com/rchauhan9/sportech/httpsecurity"
+	"github.com/rchauhan9/sportech/ratelimit"
+	"time"
+)
+
 type Config struct {
 	Port        string
+	GRPCPort    string
 	Database    DatabaseConfig
 	Environment string
+	GraphQL     GraphQLConfig
+	HTTPCache   HTTPCacheConfig
+	Cache       CacheConfig
+	Auth        AuthConfig
+	RateLimit   RateLimitConfig
+	Tracing     TracingConfig
+	Logging     LoggingConfig
+	Shutdown    ShutdownConfig
+	// CORS says which websites may call the API from a browser, and SecurityHeaders which
+	// security headers are sent with every response. Both differ between environments: HSTS, for
+	// one, should only be turned on where the API is served over HTTPS.
+	CORS            httpsecurity.Policy
+	SecurityHeaders httpsecurity.Headers
 }
 
 type DatabaseConfig struct {
 	URL string
 }
+
+// GraphQLConfig bounds the queries accepted at /graphql. MaxDepth is the deepest nesting of
+// fields allowed and MaxCost the highest estimated number of fields resolved.
+type GraphQLConfig struct {
+	MaxDepth int
+	MaxCost  int
+}
+
+// HTTPCacheConfig sets the Cache-Control header sent with successful GET responses. Resources
+// maps a resource such as "teams" to its own value; any resource not listed uses Default.
+type HTTPCacheConfig struct {
+	Default   string
+	Resources map[string]string
+}
+
+func (c HTTPCacheConfig) CacheControl(resource string) string {
+	if cacheControl, ok := c.Resources[resource]; ok {
+		return cacheControl
+	}
+	return c.Default
+}
+
+// CacheConfig sizes the in-process caches in front of the teams, stadiums, leagues and persons
+// services. Each service caches at most MaxItems items, counting every element of a list, for
+// up to TTL.
+type CacheConfig struct {
+	Enabled  bool
+	TTL      time.Duration
+	MaxItems int
+}
+
+// AuthConfig controls who may call the API. AnonymousScopes are granted to requests made without
+// a credential. BootstrapKey, if set, is stored at startup as an admin API key named "bootstrap",
+// so the first real keys can be issued; set it from the AUTH_BOOTSTRAPKEY environment variable.
+type AuthConfig struct {
+	AnonymousScopes []auth.Scope
+	BootstrapKey    string
+	JWT             JWTConfig
+}
+
+// JWTConfig accepts bearer tokens from an identity provider. Its keys are read from JWKSFile, or
+// fetched from JWKSURL and refetched every RefreshInterval. Tokens must be issued by Issuer for
+// Audience, and the roles in their RolesClaim grant the scopes listed against them in Roles.
+type JWTConfig struct {
+	Enabled         bool
+	JWKSFile        string
+	JWKSURL         string
+	RefreshInterval time.Duration
+	Issuer          string
+	Audience        string
+	RolesClaim      string
+	Roles           map[string][]auth.Scope
+	Leeway          time.Duration
+}
+
+// RateLimitConfig limits how often each client may call each group of routes, such as "players".
+// Groups not listed in Groups use Default. With Distributed set, instances share buckets through
+// the database instead of each keeping its own, and otherwise each keeps at most MaxClients.
+// TrustForwardedFor identifies anonymous clients by X-Forwarded-For, which is only safe behind a
+// proxy that sets it.
+type RateLimitConfig struct {
+	Enabled           bool
+	Distributed       bool
+	MaxClients        int
+	TrustForwardedFor bool
+	Default           ratelimit.Limit
+	Groups            map[string]ratelimit.Limit
+}
+
+func (c RateLimitConfig) Limit(group string) ratelimit.Limit {
+	if limit, ok := c.Groups[group]; ok {
+		return limit
+	}
+	return c.Default
+}
+
+// TracingConfig exports OpenTelemetry traces of HTTP and gRPC requests, the endpoints serving them
+// and the SQL they run. Exporter is "otlp", sending spans to Endpoint over OTLP/HTTP, "stdout" or
+// "file", appending them to File. SampleRatio is the fraction of new traces kept.
+type TracingConfig struct {
+	Enabled     bool
+	Exporter    string
+	Endpoint    string
+	Insecure    bool
+	File        string
+	ServiceName string
+	SampleRatio float64
+}
+
+// LoggingConfig chooses how log lines are written: Format is "logfmt" or "json".
+type LoggingConfig struct {
+	Format string
+}
+
+// ShutdownConfig controls how the service stops on SIGTERM or SIGINT. Readiness fails at once,
+// and requests are still served for ReadinessDelay while load balancers notice. The servers then
+// stop accepting connections and wait up to DrainTimeout for requests in flight, such as long
+// streams, to finish before cutting them off.
+type ShutdownConfig struct {
+	ReadinessDelay time.Duration
+	DrainTimeout   time.Duration
+}
@@ -2,14 +2,44 @@ package database
 
 import (
 	"context"
+	"github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/pgxpool"
 	"github.com/pkg/errors"
 )
 
-func NewDatabasePool(ctx context.Context, connectionString string) (*pgxpool.Pool, error) {
-	db, err := pgxpool.Connect(ctx, connectionString)
+// NewDatabasePool connects to the database, letting each of configure adjust the pool's
+// configuration first.
+func NewDatabasePool(ctx context.Context, connectionString string, configure ...func(*pgxpool.Config)) (*pgxpool.Pool, error) {
+	config, err := pgxpool.ParseConfig(connectionString)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to parse database url %s", connectionString)
+	}
+	for _, c := range configure {
+		c(config)
+	}
+	db, err := pgxpool.ConnectConfig(ctx, config)
 	if err != nil {
 		return nil, errors.Wrapf(err, "unable to connect to database %s", connectionString)
 	}
 	return db, nil
 }
+
+// LockTableVersions locks the table_versions rows of tables, in name order, for the rest of tx.
+// Every write bumps its table's row in a statement trigger, and the lock is held until commit, so
+// a transaction writing several tables must take them all up front. Otherwise two such
+// transactions writing the same tables in a different order would deadlock.
+func LockTableVersions(ctx context.Context, tx pgx.Tx, tables ...string) error {
+	query := `
+	    SELECT table_name
+	    FROM table_versions
+	    WHERE table_name = ANY($1)
+	    ORDER BY table_name
+	    FOR UPDATE
+	`
+	rows, err := tx.Query(ctx, query, tables)
+	if err != nil {
+		return errors.Wrap(err, "error locking table versions")
+	}
+	rows.Close()
+	return errors.Wrap(rows.Err(), "error locking table versions")
+}
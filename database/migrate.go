@@ -1,8 +1,11 @@
 package database
 
 import (
+	"errors"
 	"fmt"
 	"github.com/golang-migrate/migrate/v4"
+	"github.com/golang-migrate/migrate/v4/source"
+	"os"
 	"strings"
 
 	"github.com/go-kit/log"
@@ -53,6 +56,41 @@ func (m *Migrator) MigrateDb() error {
 	return nil
 }
 
+// Version is the last migration applied, and whether it failed part way through. It is 0 if no
+// migration has been applied.
+func (m *Migrator) Version() (uint, bool, error) {
+	version, dirty, err := m.migrator.Version()
+	if err == migrate.ErrNilVersion {
+		return 0, false, nil
+	}
+	return version, dirty, err
+}
+
+// LatestVersion is the version of the newest migration in the migration directory, which the
+// database is at once MigrateDb has run.
+func (m *Migrator) LatestVersion() (uint, error) {
+	migrations, err := source.Open(m.sourceURL)
+	if err != nil {
+		return 0, err
+	}
+	defer migrations.Close()
+
+	version, err := migrations.First()
+	if err != nil {
+		return 0, err
+	}
+	for {
+		next, err := migrations.Next(version)
+		if errors.Is(err, os.ErrNotExist) {
+			return version, nil
+		}
+		if err != nil {
+			return 0, err
+		}
+		version = next
+	}
+}
+
 func (m *Migrator) UnmigrateDB() error {
 	if err := m.migrator.Down(); err != nil && err != migrate.ErrNoChange {
 		return err
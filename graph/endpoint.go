@@ -0,0 +1,19 @@
+package graph
+
+import (
+	"context"
+	"github.com/go-kit/kit/endpoint"
+)
+
+type graphQLRequest struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+func MakeGraphQLEndpoint(svc Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(graphQLRequest)
+		return svc.Execute(ctx, req.Query, req.OperationName, req.Variables)
+	}
+}
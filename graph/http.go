@@ -0,0 +1,75 @@
+package graph
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/go-kit/kit/endpoint"
+	kithttp "github.com/go-kit/kit/transport/http"
+	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+	"github.com/rchauhan9/sportech/auth"
+	"github.com/rchauhan9/sportech/commons/go/logging"
+	"net/http"
+)
+
+func MakeHandler(graphQLEndpoint endpoint.Endpoint) http.Handler {
+	r := mux.NewRouter()
+
+	graphQLHandler := kithttp.NewServer(
+		graphQLEndpoint,
+		decodeGraphQLRequest,
+		encodeGraphQLResponse,
+		kithttp.ServerErrorEncoder(encodeError),
+	)
+
+	r.Handle("/graphql", graphQLHandler).Methods("GET", "POST")
+
+	return r
+}
+
+// decodeGraphQLRequest accepts a JSON body on POST, or query, operationName and variables
+// parameters on GET.
+func decodeGraphQLRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req graphQLRequest
+	if r.Method == http.MethodGet {
+		query := r.URL.Query()
+		req.Query = query.Get("query")
+		req.OperationName = query.Get("operationName")
+		if variables := query.Get("variables"); variables != "" {
+			if err := json.Unmarshal([]byte(variables), &req.Variables); err != nil {
+				return nil, errors.Wrapf(ErrInvalidArgument, "invalid variables: %s", err)
+			}
+		}
+		return req, nil
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, errors.Wrapf(ErrInvalidArgument, "invalid request body: %s", err)
+	}
+	return req, nil
+}
+
+func encodeGraphQLResponse(_ context.Context, w http.ResponseWriter, response interface{}) error {
+	return json.NewEncoder(w).Encode(response)
+}
+
+// encode errors in the shape GraphQL clients expect
+func encodeError(ctx context.Context, err error, w http.ResponseWriter) {
+	logging.RecordError(ctx, err)
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	switch errors.Cause(err) {
+	case ErrInvalidArgument:
+		w.WriteHeader(http.StatusBadRequest)
+	case auth.ErrUnauthenticated:
+		w.WriteHeader(http.StatusUnauthorized)
+	case auth.ErrForbidden:
+		w.WriteHeader(http.StatusForbidden)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"errors": []map[string]interface{}{
+			{"message": err.Error()},
+		},
+	})
+}
@@ -0,0 +1,120 @@
+package graph
+
+import (
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+	"strings"
+)
+
+// listMultiplier is the number of items a list field is assumed to return when estimating cost.
+// None of the list fields are paginated, so this is a rough guess rather than a bound.
+const listMultiplier = 10
+
+// complexity is the depth and estimated cost of a query. Depth counts nested fields, so
+// `{ team { stadium { name } } }` has depth 3. Every field costs 1, and the fields selected
+// under a list are counted listMultiplier times. Introspection fields are free.
+type complexity struct {
+	Depth int
+	Cost  int
+}
+
+// measure returns the complexity of the most complex operation in doc.
+func measure(schema graphql.Schema, doc *ast.Document) complexity {
+	fragments := make(map[string]*ast.FragmentDefinition)
+	for _, def := range doc.Definitions {
+		if fragment, ok := def.(*ast.FragmentDefinition); ok {
+			fragments[fragment.Name.Value] = fragment
+		}
+	}
+
+	var worst complexity
+	for _, def := range doc.Definitions {
+		operation, ok := def.(*ast.OperationDefinition)
+		if !ok {
+			continue
+		}
+		var root graphql.Type
+		switch operation.Operation {
+		case ast.OperationTypeQuery:
+			root = schema.QueryType()
+		case ast.OperationTypeMutation:
+			root = schema.MutationType()
+		}
+		c := measureSelections(operation.SelectionSet, root, 0, fragments, map[string]bool{})
+		if c.Depth > worst.Depth {
+			worst.Depth = c.Depth
+		}
+		if c.Cost > worst.Cost {
+			worst.Cost = c.Cost
+		}
+	}
+	return worst
+}
+
+func measureSelections(set *ast.SelectionSet, parent graphql.Type, depth int, fragments map[string]*ast.FragmentDefinition, visiting map[string]bool) complexity {
+	c := complexity{Depth: depth}
+	if set == nil {
+		return c
+	}
+
+	add := func(child complexity, multiplier int) {
+		if child.Depth > c.Depth {
+			c.Depth = child.Depth
+		}
+		c.Cost += child.Cost * multiplier
+	}
+
+	for _, selection := range set.Selections {
+		switch s := selection.(type) {
+		case *ast.Field:
+			if strings.HasPrefix(s.Name.Value, "__") {
+				continue
+			}
+			fieldType, multiplier := unwrap(fieldType(parent, s.Name.Value))
+			child := measureSelections(s.SelectionSet, fieldType, depth+1, fragments, visiting)
+			c.Cost++
+			add(child, multiplier)
+		case *ast.InlineFragment:
+			add(measureSelections(s.SelectionSet, parent, depth, fragments, visiting), 1)
+		case *ast.FragmentSpread:
+			name := s.Name.Value
+			fragment, ok := fragments[name]
+			if !ok || visiting[name] {
+				continue
+			}
+			visiting[name] = true
+			add(measureSelections(fragment.SelectionSet, parent, depth, fragments, visiting), 1)
+			delete(visiting, name)
+		}
+	}
+	return c
+}
+
+func fieldType(parent graphql.Type, name string) graphql.Type {
+	object, ok := parent.(*graphql.Object)
+	if !ok || object == nil {
+		return nil
+	}
+	field, ok := object.Fields()[name]
+	if !ok {
+		return nil
+	}
+	return field.Type
+}
+
+// unwrap strips non-null and list wrappers from t, returning the named type underneath and how
+// many times its selections should be counted.
+func unwrap(t graphql.Type) (graphql.Type, int) {
+	multiplier := 1
+	for {
+		switch wrapper := t.(type) {
+		case *graphql.NonNull:
+			t = wrapper.OfType
+		case *graphql.List:
+			multiplier *= listMultiplier
+			t = wrapper.OfType
+		default:
+			return t, multiplier
+		}
+	}
+}
@@ -0,0 +1,145 @@
+package graph
+
+import (
+	"context"
+	"github.com/graphql-go/graphql"
+	"github.com/rchauhan9/sportech/leagues"
+	"github.com/rchauhan9/sportech/managers"
+	"github.com/rchauhan9/sportech/players"
+	"github.com/rchauhan9/sportech/stadiums"
+	"github.com/rchauhan9/sportech/teams"
+	"sync"
+	"time"
+)
+
+// loader collects the keys asked for while one level of a query resolves, and fetches them with
+// a single call when the first of their values is needed. Resolvers return the thunk from load,
+// which graphql-go only calls once every sibling field has been resolved.
+type loader[K comparable, V any] struct {
+	fetch   func(ctx context.Context, keys []K) (map[K]V, error)
+	mu      sync.Mutex
+	pending []K
+	results map[K]result[V]
+}
+
+type result[V any] struct {
+	value V
+	found bool
+	err   error
+}
+
+func newLoader[K comparable, V any](fetch func(ctx context.Context, keys []K) (map[K]V, error)) *loader[K, V] {
+	return &loader[K, V]{fetch: fetch, results: map[K]result[V]{}}
+}
+
+func (l *loader[K, V]) load(ctx context.Context, key K) func() (V, bool, error) {
+	l.mu.Lock()
+	if _, ok := l.results[key]; !ok {
+		l.results[key] = result[V]{}
+		l.pending = append(l.pending, key)
+	}
+	l.mu.Unlock()
+
+	return func() (V, bool, error) {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		if len(l.pending) > 0 {
+			keys := l.pending
+			l.pending = nil
+			values, err := l.fetch(ctx, keys)
+			for _, k := range keys {
+				value, found := values[k]
+				l.results[k] = result[V]{value: value, found: found, err: err}
+			}
+		}
+		r := l.results[key]
+		return r.value, r.found, r.err
+	}
+}
+
+// dateKey identifies a row as it was on a date.
+type dateKey struct {
+	id   string
+	asOf time.Time
+}
+
+// byDate fetches dated keys with one call per distinct date; a query usually has only one.
+func byDate[V any](keys []dateKey, fetch func(ids []string, asOf time.Time) (map[string]V, error)) (map[dateKey]V, error) {
+	ids := map[time.Time][]string{}
+	for _, key := range keys {
+		ids[key.asOf] = append(ids[key.asOf], key.id)
+	}
+	values := make(map[dateKey]V, len(keys))
+	for asOf, idsOn := range ids {
+		fetched, err := fetch(idsOn, asOf)
+		if err != nil {
+			return nil, err
+		}
+		for id, value := range fetched {
+			values[dateKey{id: id, asOf: asOf}] = value
+		}
+	}
+	return values, nil
+}
+
+// dated is a source value along with the date the query asked for, so its relations resolve as
+// of the same date. Its own fields resolve from value as usual.
+type dated[T any] struct {
+	value T
+	asOf  time.Time
+}
+
+func (d dated[T]) Resolve(p graphql.ResolveParams) (interface{}, error) {
+	p.Source = d.value
+	return graphql.DefaultResolveFn(p)
+}
+
+// loaders resolve the nested relations of a single request. A new set is made for each request
+// so nothing is cached between them.
+type loaders struct {
+	teams          *loader[dateKey, teams.Team]
+	teamsByLeague  *loader[dateKey, []teams.Team]
+	stadiums       *loader[dateKey, stadiums.Stadium]
+	leagues        *loader[string, leagues.League]
+	playersByTeam  *loader[string, []players.Player]
+	managersByTeam *loader[string, []managers.Manager]
+}
+
+func newLoaders(s *service) *loaders {
+	return &loaders{
+		teams: newLoader(func(ctx context.Context, keys []dateKey) (map[dateKey]teams.Team, error) {
+			return byDate(keys, func(ids []string, asOf time.Time) (map[string]teams.Team, error) {
+				return s.teams.GetTeams(ctx, ids, asOf)
+			})
+		}),
+		teamsByLeague: newLoader(func(ctx context.Context, keys []dateKey) (map[dateKey][]teams.Team, error) {
+			return byDate(keys, func(ids []string, asOf time.Time) (map[string][]teams.Team, error) {
+				return s.teams.ListTeamsInLeagues(ctx, ids, asOf)
+			})
+		}),
+		stadiums: newLoader(func(ctx context.Context, keys []dateKey) (map[dateKey]stadiums.Stadium, error) {
+			return byDate(keys, func(ids []string, asOf time.Time) (map[string]stadiums.Stadium, error) {
+				return s.stadiums.GetStadiums(ctx, ids, asOf)
+			})
+		}),
+		leagues: newLoader(func(ctx context.Context, ids []string) (map[string]leagues.League, error) {
+			return s.leagues.GetLeagues(ctx, ids)
+		}),
+		playersByTeam: newLoader(func(ctx context.Context, ids []string) (map[string][]players.Player, error) {
+			return s.players.ListPlayersInTeams(ctx, ids)
+		}),
+		managersByTeam: newLoader(func(ctx context.Context, ids []string) (map[string][]managers.Manager, error) {
+			return s.managers.ListManagersInTeams(ctx, ids)
+		}),
+	}
+}
+
+type loadersKey struct{}
+
+func withLoaders(ctx context.Context, l *loaders) context.Context {
+	return context.WithValue(ctx, loadersKey{}, l)
+}
+
+func loadersFrom(ctx context.Context) *loaders {
+	return ctx.Value(loadersKey{}).(*loaders)
+}
@@ -0,0 +1,35 @@
+package graph
+
+import (
+	"github.com/graphql-go/graphql"
+	"github.com/rchauhan9/sportech/openapi"
+	"net/http"
+)
+
+const graphQLDescription = "Introspect the schema for the types and fields available. Queries deeper or " +
+	"more costly than the configured limits are rejected with a 400 and a GraphQL errors array."
+
+var Routes = []openapi.Route{
+	{
+		Method:      http.MethodGet,
+		Path:        "/graphql",
+		Tag:         "graphql",
+		Summary:     "Run a GraphQL query",
+		Description: graphQLDescription,
+		Query: []openapi.Parameter{
+			{Name: "query", Type: "string", Description: "The GraphQL query.", Required: true},
+			openapi.QueryParam("operationName", "string", "The operation to run if the query has several."),
+			openapi.QueryParam("variables", "string", "A JSON object of variable values."),
+		},
+		Response: graphql.Result{},
+	},
+	{
+		Method:      http.MethodPost,
+		Path:        "/graphql",
+		Tag:         "graphql",
+		Summary:     "Run a GraphQL query",
+		Description: graphQLDescription,
+		Request:     graphQLRequest{},
+		Response:    graphql.Result{},
+	},
+}
@@ -0,0 +1,347 @@
+package graph
+
+import (
+	"context"
+	"github.com/graphql-go/graphql"
+	"github.com/rchauhan9/sportech/commons/go/queryutil"
+	"github.com/rchauhan9/sportech/leagues"
+	"github.com/rchauhan9/sportech/managers"
+	"github.com/rchauhan9/sportech/persons"
+	"github.com/rchauhan9/sportech/players"
+	"github.com/rchauhan9/sportech/stadiums"
+	"github.com/rchauhan9/sportech/teams"
+	"time"
+)
+
+// newSchema builds the schema served at /graphql. Root fields call the services directly and
+// relations between types go through the request's loaders.
+func newSchema(s *service) (graphql.Schema, error) {
+	var teamType, leagueType, playerType, managerType *graphql.Object
+
+	aliasType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Alias",
+		Fields: graphql.Fields{
+			"name":   &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"locale": &graphql.Field{Type: graphql.String},
+		},
+	})
+
+	stadiumType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Stadium",
+		Fields: graphql.Fields{
+			"id":         &graphql.Field{Type: graphql.NewNonNull(graphql.ID)},
+			"name":       &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"capacity":   &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+			"city":       &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"country":    &graphql.Field{Type: graphql.NewNonNull(graphql.ID)},
+			"latitude":   &graphql.Field{Type: graphql.Float},
+			"longitude":  &graphql.Field{Type: graphql.Float},
+			"elevation":  &graphql.Field{Type: graphql.Int},
+			"surface":    &graphql.Field{Type: graphql.String},
+			"yearOpened": &graphql.Field{Type: graphql.Int},
+		},
+	})
+
+	teamType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Team",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"id":          &graphql.Field{Type: graphql.NewNonNull(graphql.ID)},
+				"fullName":    &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+				"mediumName":  &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+				"acronym":     &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+				"nickname":    &graphql.Field{Type: graphql.String},
+				"yearFounded": &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+				"city":        &graphql.Field{Type: graphql.String},
+				"country":     &graphql.Field{Type: graphql.NewNonNull(graphql.ID)},
+				"stadium": &graphql.Field{
+					Type: stadiumType,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						team := p.Source.(dated[teams.Team])
+						key := dateKey{id: team.value.Stadium, asOf: team.asOf}
+						return resolveOne(p.Context, loadersFrom(p.Context).stadiums, key, team.asOf), nil
+					},
+				},
+				"league": &graphql.Field{
+					Type: leagueType,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						team := p.Source.(dated[teams.Team])
+						return resolveOne(p.Context, loadersFrom(p.Context).leagues, team.value.League, team.asOf), nil
+					},
+				},
+				"players": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(playerType))),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						team := p.Source.(dated[teams.Team])
+						return resolveMany(p.Context, loadersFrom(p.Context).playersByTeam, team.value.ID, team.asOf), nil
+					},
+				},
+				"managers": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(managerType))),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						team := p.Source.(dated[teams.Team])
+						return resolveMany(p.Context, loadersFrom(p.Context).managersByTeam, team.value.ID, team.asOf), nil
+					},
+				},
+			}
+		}),
+	})
+
+	leagueType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "League",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"id":            &graphql.Field{Type: graphql.NewNonNull(graphql.ID)},
+				"name":          &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+				"numberOfTeams": &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+				"country":       &graphql.Field{Type: graphql.NewNonNull(graphql.ID)},
+				"tier":          &graphql.Field{Type: graphql.Int},
+				"teams": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(teamType))),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						league := p.Source.(dated[leagues.League])
+						key := dateKey{id: league.value.ID, asOf: league.asOf}
+						return resolveMany(p.Context, loadersFrom(p.Context).teamsByLeague, key, league.asOf), nil
+					},
+				},
+			}
+		}),
+	})
+
+	playerType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Player",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"id":          &graphql.Field{Type: graphql.NewNonNull(graphql.ID)},
+				"firstName":   &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+				"middleNames": &graphql.Field{Type: graphql.String},
+				"lastName":    &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+				"knownAs":     &graphql.Field{Type: graphql.String},
+				"dateOfBirth": &graphql.Field{Type: graphql.NewNonNull(graphql.DateTime)},
+				"nationality": &graphql.Field{Type: graphql.NewNonNull(graphql.ID)},
+				"aliases": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(aliasType))),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return nonNil[persons.Alias](p.Source.(dated[players.Player]).value.Aliases), nil
+					},
+				},
+				"team": &graphql.Field{
+					Type: teamType,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						player := p.Source.(dated[players.Player])
+						key := dateKey{id: player.value.Team, asOf: player.asOf}
+						return resolveOne(p.Context, loadersFrom(p.Context).teams, key, player.asOf), nil
+					},
+				},
+				"squadNumber":      &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+				"generalPosition":  &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+				"specificPosition": &graphql.Field{Type: graphql.String},
+				"started":          &graphql.Field{Type: graphql.NewNonNull(graphql.DateTime)},
+				"ended":            &graphql.Field{Type: graphql.DateTime},
+			}
+		}),
+	})
+
+	managerType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Manager",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"id":          &graphql.Field{Type: graphql.NewNonNull(graphql.ID)},
+				"firstName":   &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+				"middleNames": &graphql.Field{Type: graphql.String},
+				"lastName":    &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+				"knownAs":     &graphql.Field{Type: graphql.String},
+				"dateOfBirth": &graphql.Field{Type: graphql.NewNonNull(graphql.DateTime)},
+				"nationality": &graphql.Field{Type: graphql.NewNonNull(graphql.ID)},
+				"aliases": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(aliasType))),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return nonNil[persons.Alias](p.Source.(dated[managers.Manager]).value.Aliases), nil
+					},
+				},
+				"team": &graphql.Field{
+					Type: teamType,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						manager := p.Source.(dated[managers.Manager])
+						key := dateKey{id: manager.value.Team, asOf: manager.asOf}
+						return resolveOne(p.Context, loadersFrom(p.Context).teams, key, manager.asOf), nil
+					},
+				},
+				"started": &graphql.Field{Type: graphql.NewNonNull(graphql.DateTime)},
+				"ended":   &graphql.Field{Type: graphql.DateTime},
+			}
+		}),
+	})
+
+	idArgs := graphql.FieldConfigArgument{
+		"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+	}
+
+	queryType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"team": &graphql.Field{
+				Type: teamType,
+				Args: graphql.FieldConfigArgument{
+					"id":   &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+					"asOf": &graphql.ArgumentConfig{Type: graphql.String},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					asOf, err := asOfArg(p.Args)
+					if err != nil {
+						return nil, err
+					}
+					team, err := s.teams.GetTeam(p.Context, p.Args["id"].(string), asOf)
+					return dated[teams.Team]{value: team, asOf: asOf}, err
+				},
+			},
+			"teams": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(teamType))),
+				Args: graphql.FieldConfigArgument{
+					"name": &graphql.ArgumentConfig{Type: graphql.String},
+					"asOf": &graphql.ArgumentConfig{Type: graphql.String},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					asOf, err := asOfArg(p.Args)
+					if err != nil {
+						return nil, err
+					}
+					var ts []teams.Team
+					if name, ok := p.Args["name"].(string); ok {
+						ts, err = s.teams.FindTeamsByName(p.Context, name, asOf)
+					} else {
+						ts, err = s.teams.ListTeams(p.Context, asOf)
+					}
+					return datedAll(ts, asOf), err
+				},
+			},
+			"stadium": &graphql.Field{
+				Type: stadiumType,
+				Args: graphql.FieldConfigArgument{
+					"id":   &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+					"asOf": &graphql.ArgumentConfig{Type: graphql.String},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					asOf, err := asOfArg(p.Args)
+					if err != nil {
+						return nil, err
+					}
+					stadium, err := s.stadiums.GetStadium(p.Context, p.Args["id"].(string), asOf)
+					return dated[stadiums.Stadium]{value: stadium, asOf: asOf}, err
+				},
+			},
+			"stadiums": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(stadiumType))),
+				Args: graphql.FieldConfigArgument{
+					"name": &graphql.ArgumentConfig{Type: graphql.String},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					var ss []stadiums.Stadium
+					var err error
+					if name, ok := p.Args["name"].(string); ok {
+						ss, err = s.stadiums.FindStadiumsByName(p.Context, name)
+					} else {
+						ss, err = s.stadiums.ListStadiums(p.Context)
+					}
+					return datedAll(ss, queryutil.Today()), err
+				},
+			},
+			"league": &graphql.Field{
+				Type: leagueType,
+				Args: idArgs,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					league, err := s.leagues.GetLeague(p.Context, p.Args["id"].(string))
+					return dated[leagues.League]{value: league, asOf: queryutil.Today()}, err
+				},
+			},
+			"leagues": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(leagueType))),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					ls, err := s.leagues.ListLeagues(p.Context)
+					return datedAll(ls, queryutil.Today()), err
+				},
+			},
+			"player": &graphql.Field{
+				Type: playerType,
+				Args: idArgs,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					player, err := s.players.GetPlayer(p.Context, p.Args["id"].(string))
+					return dated[players.Player]{value: player, asOf: queryutil.Today()}, err
+				},
+			},
+			"players": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(playerType))),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					ps, err := s.players.ListPlayers(p.Context)
+					return datedAll(ps, queryutil.Today()), err
+				},
+			},
+			"manager": &graphql.Field{
+				Type: managerType,
+				Args: idArgs,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					manager, err := s.managers.GetManager(p.Context, p.Args["id"].(string))
+					return dated[managers.Manager]{value: manager, asOf: queryutil.Today()}, err
+				},
+			},
+			"managers": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(managerType))),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					ms, err := s.managers.ListManagers(p.Context)
+					return datedAll(ms, queryutil.Today()), err
+				},
+			},
+		},
+	})
+
+	return graphql.NewSchema(graphql.SchemaConfig{Query: queryType})
+}
+
+func asOfArg(args map[string]interface{}) (time.Time, error) {
+	asOf, _ := args["asOf"].(string)
+	return queryutil.Date("asOf", asOf, ErrInvalidArgument)
+}
+
+// resolveOne returns a thunk for the value loaded for key, which resolves to null when there is
+// none.
+func resolveOne[K comparable, V any](ctx context.Context, l *loader[K, V], key K, asOf time.Time) func() (interface{}, error) {
+	load := l.load(ctx, key)
+	return func() (interface{}, error) {
+		value, found, err := load()
+		if err != nil || !found {
+			return nil, err
+		}
+		return dated[V]{value: value, asOf: asOf}, nil
+	}
+}
+
+// resolveMany returns a thunk for the values loaded for key, which resolves to an empty list when
+// there are none, as non-null list fields cannot resolve to null.
+func resolveMany[K comparable, V any](ctx context.Context, l *loader[K, []V], key K, asOf time.Time) func() (interface{}, error) {
+	load := l.load(ctx, key)
+	return func() (interface{}, error) {
+		values, _, err := load()
+		if err != nil {
+			return nil, err
+		}
+		return datedAll(values, asOf), nil
+	}
+}
+
+// datedAll wraps each value with asOf. A nil slice becomes an empty one, as non-null list fields
+// cannot resolve to null.
+func datedAll[T any](values []T, asOf time.Time) []dated[T] {
+	all := make([]dated[T], len(values))
+	for i, value := range values {
+		all[i] = dated[T]{value: value, asOf: asOf}
+	}
+	return all
+}
+
+// nonNil turns a nil slice into an empty one, as non-null list fields cannot resolve to null.
+func nonNil[T any](xs []T) []T {
+	if xs == nil {
+		return []T{}
+	}
+	return xs
+}
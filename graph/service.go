@@ -0,0 +1,112 @@
+package graph
+
+import (
+	"context"
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/graphql-go/graphql/language/source"
+	"github.com/pkg/errors"
+	"github.com/rchauhan9/sportech/leagues"
+	"github.com/rchauhan9/sportech/managers"
+	"github.com/rchauhan9/sportech/players"
+	"github.com/rchauhan9/sportech/stadiums"
+	"github.com/rchauhan9/sportech/teams"
+)
+
+const (
+	DefaultMaxDepth = 7
+	DefaultMaxCost  = 5000
+)
+
+var ErrInvalidArgument = errors.New("invalid argument")
+
+type Service interface {
+	Execute(ctx context.Context, query string, operationName string, variables map[string]interface{}) (*graphql.Result, error)
+}
+
+// NewService builds the GraphQL schema over the other services. Queries nested deeper than
+// maxDepth or estimated to cost more than maxCost are rejected before they run; zero values
+// fall back to DefaultMaxDepth and DefaultMaxCost.
+func NewService(
+	teamsService teams.Service,
+	stadiumsService stadiums.Service,
+	leaguesService leagues.Service,
+	playersService players.Service,
+	managersService managers.Service,
+	maxDepth int,
+	maxCost int,
+) (Service, error) {
+	if maxDepth <= 0 {
+		maxDepth = DefaultMaxDepth
+	}
+	if maxCost <= 0 {
+		maxCost = DefaultMaxCost
+	}
+
+	s := &service{
+		teams:    teamsService,
+		stadiums: stadiumsService,
+		leagues:  leaguesService,
+		players:  playersService,
+		managers: managersService,
+		maxDepth: maxDepth,
+		maxCost:  maxCost,
+	}
+
+	schema, err := newSchema(s)
+	if err != nil {
+		return nil, errors.Wrap(err, "error building graphql schema")
+	}
+	s.schema = schema
+
+	return s, nil
+}
+
+type service struct {
+	schema   graphql.Schema
+	teams    teams.Service
+	stadiums stadiums.Service
+	leagues  leagues.Service
+	players  players.Service
+	managers managers.Service
+	maxDepth int
+	maxCost  int
+}
+
+// Execute runs a query with a fresh set of loaders. Syntax and validation errors are reported in
+// the result, as GraphQL clients expect; queries over the depth or cost limit return
+// ErrInvalidArgument.
+func (s *service) Execute(ctx context.Context, query string, operationName string, variables map[string]interface{}) (*graphql.Result, error) {
+	if query == "" {
+		return nil, errors.Wrap(ErrInvalidArgument, "query must not be empty")
+	}
+
+	doc, err := parser.Parse(parser.ParseParams{
+		Source: source.NewSource(&source.Source{Body: []byte(query), Name: "GraphQL request"}),
+	})
+	if err != nil {
+		return &graphql.Result{Errors: gqlerrors.FormatErrors(err)}, nil
+	}
+
+	validation := graphql.ValidateDocument(&s.schema, doc, nil)
+	if !validation.IsValid {
+		return &graphql.Result{Errors: validation.Errors}, nil
+	}
+
+	c := measure(s.schema, doc)
+	if c.Depth > s.maxDepth {
+		return nil, errors.Wrapf(ErrInvalidArgument, "query depth %d exceeds the maximum of %d", c.Depth, s.maxDepth)
+	}
+	if c.Cost > s.maxCost {
+		return nil, errors.Wrapf(ErrInvalidArgument, "query cost %d exceeds the maximum of %d", c.Cost, s.maxCost)
+	}
+
+	return graphql.Execute(graphql.ExecuteParams{
+		Schema:        s.schema,
+		AST:           doc,
+		OperationName: operationName,
+		Args:          variables,
+		Context:       withLoaders(ctx, newLoaders(s)),
+	}), nil
+}
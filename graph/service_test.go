@@ -0,0 +1,240 @@
+package graph
+
+import (
+	"context"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/pkg/errors"
+	"github.com/rchauhan9/sportech/leagues"
+	"github.com/rchauhan9/sportech/managers"
+	"github.com/rchauhan9/sportech/players"
+	"github.com/rchauhan9/sportech/stadiums"
+	"github.com/rchauhan9/sportech/teams"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+// call records the keys a batch method was asked for, and the date where it takes one.
+type call struct {
+	ids  []string
+	asOf time.Time
+}
+
+type fakeTeams struct {
+	teams.Service
+	teams []teams.Team
+	calls []call
+}
+
+func (f *fakeTeams) ListTeams(_ context.Context, _ time.Time) ([]teams.Team, error) {
+	return f.teams, nil
+}
+
+func (f *fakeTeams) GetTeam(_ context.Context, id string, _ time.Time) (teams.Team, error) {
+	for _, team := range f.teams {
+		if team.ID == id {
+			return team, nil
+		}
+	}
+	return teams.Team{}, errors.New("no such team")
+}
+
+func (f *fakeTeams) GetTeams(_ context.Context, ids []string, asOf time.Time) (map[string]teams.Team, error) {
+	f.calls = append(f.calls, call{ids: ids, asOf: asOf})
+	byID := map[string]teams.Team{}
+	for _, team := range f.teams {
+		if lo.Contains(ids, team.ID) {
+			byID[team.ID] = team
+		}
+	}
+	return byID, nil
+}
+
+type fakeStadiums struct {
+	stadiums.Service
+	stadiums []stadiums.Stadium
+	calls    []call
+}
+
+func (f *fakeStadiums) GetStadiums(_ context.Context, ids []string, asOf time.Time) (map[string]stadiums.Stadium, error) {
+	f.calls = append(f.calls, call{ids: ids, asOf: asOf})
+	byID := map[string]stadiums.Stadium{}
+	for _, stadium := range f.stadiums {
+		if lo.Contains(ids, stadium.ID) {
+			byID[stadium.ID] = stadium
+		}
+	}
+	return byID, nil
+}
+
+type fakeLeagues struct {
+	leagues.Service
+	leagues []leagues.League
+	calls   []call
+}
+
+func (f *fakeLeagues) GetLeagues(_ context.Context, ids []string) (map[string]leagues.League, error) {
+	f.calls = append(f.calls, call{ids: ids})
+	byID := map[string]leagues.League{}
+	for _, league := range f.leagues {
+		if lo.Contains(ids, league.ID) {
+			byID[league.ID] = league
+		}
+	}
+	return byID, nil
+}
+
+type fakePlayers struct {
+	players.Service
+	players []players.Player
+	calls   []call
+}
+
+func (f *fakePlayers) ListPlayersInTeams(_ context.Context, teamIDs []string) (map[string][]players.Player, error) {
+	f.calls = append(f.calls, call{ids: teamIDs})
+	byTeam := map[string][]players.Player{}
+	for _, player := range f.players {
+		if lo.Contains(teamIDs, player.Team) {
+			byTeam[player.Team] = append(byTeam[player.Team], player)
+		}
+	}
+	return byTeam, nil
+}
+
+type fakeManagers struct {
+	managers.Service
+}
+
+func newTestService(t *testing.T, maxDepth int, maxCost int) (*service, *fakeTeams, *fakeStadiums, *fakeLeagues, *fakePlayers) {
+	ts := &fakeTeams{teams: []teams.Team{
+		{ID: "liverpool", FullName: "Liverpool Football Club", Stadium: "anfield", League: "premier-league"},
+		{ID: "everton", FullName: "Everton Football Club", Stadium: "goodison", League: "premier-league"},
+		{ID: "wrexham", FullName: "Wrexham Association Football Club", Stadium: "racecourse", League: "league-two"},
+	}}
+	ss := &fakeStadiums{stadiums: []stadiums.Stadium{
+		{ID: "anfield", Name: "Anfield"},
+		{ID: "goodison", Name: "Goodison Park"},
+	}}
+	ls := &fakeLeagues{leagues: []leagues.League{
+		{ID: "premier-league", Name: "Premier League"},
+		{ID: "league-two", Name: "League Two"},
+	}}
+	ps := &fakePlayers{players: []players.Player{
+		{ID: "salah", LastName: "Salah", Team: "liverpool"},
+		{ID: "alisson", LastName: "Becker", Team: "liverpool"},
+	}}
+
+	svc, err := NewService(ts, ss, ls, ps, &fakeManagers{}, maxDepth, maxCost)
+	require.NoError(t, err)
+	return svc.(*service), ts, ss, ls, ps
+}
+
+func TestExecuteBatchesRelations(t *testing.T) {
+	svc, _, ss, ls, ps := newTestService(t, 0, 0)
+
+	result, err := svc.Execute(context.Background(), `{
+		teams {
+			id
+			stadium { name }
+			league { name }
+			players { lastName }
+		}
+	}`, "", nil)
+	require.NoError(t, err)
+	require.Empty(t, result.Errors)
+
+	require.Equal(t, 1, len(ss.calls))
+	require.ElementsMatch(t, []string{"anfield", "goodison", "racecourse"}, ss.calls[0].ids)
+	require.Equal(t, 1, len(ls.calls))
+	require.ElementsMatch(t, []string{"premier-league", "league-two"}, ls.calls[0].ids)
+	require.Equal(t, 1, len(ps.calls))
+	require.ElementsMatch(t, []string{"liverpool", "everton", "wrexham"}, ps.calls[0].ids)
+
+	data := result.Data.(map[string]interface{})["teams"].([]interface{})
+	require.Equal(t, 3, len(data))
+	require.Equal(t, map[string]interface{}{"name": "Anfield"}, data[0].(map[string]interface{})["stadium"])
+	require.Equal(t, 2, len(data[0].(map[string]interface{})["players"].([]interface{})))
+	require.Nil(t, data[2].(map[string]interface{})["stadium"])
+	require.Equal(t, []interface{}{}, data[2].(map[string]interface{})["players"])
+}
+
+func TestExecuteLoadersArePerRequest(t *testing.T) {
+	svc, _, ss, _, _ := newTestService(t, 0, 0)
+
+	for i := 0; i < 2; i++ {
+		_, err := svc.Execute(context.Background(), `{ teams { stadium { name } } }`, "", nil)
+		require.NoError(t, err)
+	}
+
+	require.Equal(t, 2, len(ss.calls))
+}
+
+func TestExecuteResolvesRelationsAsOfParent(t *testing.T) {
+	svc, ts, ss, _, _ := newTestService(t, 0, 0)
+
+	result, err := svc.Execute(context.Background(), `{
+		team(id: "liverpool", asOf: "2010-05-01") {
+			stadium { name }
+			players { team { id } }
+		}
+	}`, "", nil)
+	require.NoError(t, err)
+	require.Empty(t, result.Errors)
+
+	asOf := time.Date(2010, 5, 1, 0, 0, 0, 0, time.UTC)
+	require.Equal(t, []call{{ids: []string{"anfield"}, asOf: asOf}}, ss.calls)
+	require.Equal(t, []call{{ids: []string{"liverpool"}, asOf: asOf}}, ts.calls)
+}
+
+func TestExecuteRejectsDeepQueries(t *testing.T) {
+	svc, _, _, _, _ := newTestService(t, 3, 0)
+
+	_, err := svc.Execute(context.Background(), `{ teams { league { name } } }`, "", nil)
+	require.NoError(t, err)
+
+	_, err = svc.Execute(context.Background(), `{ teams { league { teams { id } } } }`, "", nil)
+	require.True(t, errors.Is(err, ErrInvalidArgument))
+}
+
+func TestExecuteRejectsCostlyQueries(t *testing.T) {
+	svc, _, _, _, _ := newTestService(t, 0, 100)
+
+	_, err := svc.Execute(context.Background(), `{ teams { id players { id } } }`, "", nil)
+	require.True(t, errors.Is(err, ErrInvalidArgument))
+}
+
+func TestExecuteReportsSyntaxErrors(t *testing.T) {
+	svc, _, _, _, _ := newTestService(t, 0, 0)
+
+	result, err := svc.Execute(context.Background(), `{ teams { id `, "", nil)
+	require.NoError(t, err)
+	require.NotEmpty(t, result.Errors)
+	require.Nil(t, result.Data)
+}
+
+func TestMeasure(t *testing.T) {
+	svc, _, _, _, _ := newTestService(t, 0, 0)
+
+	cases := []struct {
+		query    string
+		expected complexity
+	}{
+		{`{ leagues { id } }`, complexity{Depth: 2, Cost: 11}},
+		{`{ team(id: "liverpool") { id stadium { name } } }`, complexity{Depth: 3, Cost: 4}},
+		{`{ team(id: "liverpool") { players { id } } }`, complexity{Depth: 3, Cost: 12}},
+		{`{ team(id: "liverpool") { ...squad } } fragment squad on Team { players { id } }`, complexity{Depth: 3, Cost: 12}},
+		{`{ __schema { types { name fields { name } } } }`, complexity{Depth: 0, Cost: 0}},
+	}
+	for _, c := range cases {
+		doc := parse(t, c.query)
+		require.Equal(t, c.expected, measure(svc.schema, doc), c.query)
+	}
+}
+
+func parse(t *testing.T, query string) *ast.Document {
+	doc, err := parser.Parse(parser.ParseParams{Source: query})
+	require.NoError(t, err)
+	return doc
+}
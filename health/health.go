@@ -0,0 +1,131 @@
+package health
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+)
+
+type Status string
+
+const (
+	StatusOK   Status = "ok"
+	StatusWarn Status = "warn"
+	StatusFail Status = "fail"
+)
+
+// saturationWarning is the share of the pool's connections in use at which readiness warns that
+// the pool is close to full. A full pool slows requests down rather than failing them, so it does
+// not fail readiness.
+const saturationWarning = 0.9
+
+// Result is the outcome of a single check.
+type Result struct {
+	Status  Status      `json:"status"`
+	Error   string      `json:"error,omitempty"`
+	Details interface{} `json:"details,omitempty"`
+}
+
+// Check reports whether something the service depends on is usable.
+type Check func(ctx context.Context) Result
+
+// Report is the outcome of every check. Its Status is StatusFail if any check failed.
+type Report struct {
+	Status Status            `json:"status"`
+	Checks map[string]Result `json:"checks"`
+}
+
+// Checker runs the checks that decide whether the service is ready for traffic.
+type Checker struct {
+	names    []string
+	checks   map[string]Check
+	timeout  time.Duration
+	draining int32
+}
+
+// NewChecker creates a Checker whose checks are given timeout to complete between them.
+func NewChecker(timeout time.Duration) *Checker {
+	return &Checker{checks: map[string]Check{}, timeout: timeout}
+}
+
+func (c *Checker) Add(name string, check Check) {
+	if _, ok := c.checks[name]; !ok {
+		c.names = append(c.names, name)
+	}
+	c.checks[name] = check
+}
+
+// Drain makes every later Check fail, so that no new traffic is sent while the service shuts
+// down.
+func (c *Checker) Drain() {
+	atomic.StoreInt32(&c.draining, 1)
+}
+
+func (c *Checker) Check(ctx context.Context) Report {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	report := Report{Status: StatusOK, Checks: map[string]Result{}}
+	if atomic.LoadInt32(&c.draining) == 1 {
+		report.Status = StatusFail
+		report.Checks["shutdown"] = Result{Status: StatusFail, Error: "shutting down"}
+	}
+	for _, name := range c.names {
+		result := c.checks[name](ctx)
+		report.Checks[name] = result
+		if result.Status == StatusFail {
+			report.Status = StatusFail
+		}
+	}
+	return report
+}
+
+// DatabaseCheck fails if the database cannot be reached.
+func DatabaseCheck(repository Repository) Check {
+	return func(ctx context.Context) Result {
+		if err := repository.Ping(ctx); err != nil {
+			return Result{Status: StatusFail, Error: err.Error()}
+		}
+		return Result{Status: StatusOK}
+	}
+}
+
+// MigrationCheck fails if the schema is behind expected, the newest migration this build knows
+// of, or the last migration did not complete. A schema ahead of expected passes, so instances of
+// the previous release stay ready while a rolling deploy migrates the database under them.
+func MigrationCheck(repository Repository, expected uint) Check {
+	return func(ctx context.Context) Result {
+		version, dirty, err := repository.MigrationVersion(ctx)
+		if err != nil {
+			return Result{Status: StatusFail, Error: err.Error()}
+		}
+		details := map[string]interface{}{"version": version, "expected": expected, "dirty": dirty}
+		switch {
+		case dirty:
+			return Result{Status: StatusFail, Error: fmt.Sprintf("migration %d did not complete", version), Details: details}
+		case version < expected:
+			return Result{Status: StatusFail, Error: fmt.Sprintf("schema is at version %d, expected at least %d", version, expected), Details: details}
+		}
+		return Result{Status: StatusOK, Details: details}
+	}
+}
+
+// PoolCheck reports how many of the pool's connections are in use, warning when nearly all are.
+func PoolCheck(repository Repository) Check {
+	return func(ctx context.Context) Result {
+		stat := repository.PoolStat()
+		saturation := 0.0
+		if stat.MaxConns > 0 {
+			saturation = float64(stat.AcquiredConns) / float64(stat.MaxConns)
+		}
+		details := struct {
+			PoolStat
+			Saturation float64 `json:"saturation"`
+		}{stat, saturation}
+		if saturation >= saturationWarning {
+			return Result{Status: StatusWarn, Error: fmt.Sprintf("%d of %d connections in use", stat.AcquiredConns, stat.MaxConns), Details: details}
+		}
+		return Result{Status: StatusOK, Details: details}
+	}
+}
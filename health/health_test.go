@@ -0,0 +1,105 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+type fakeRepository struct {
+	pingErr error
+	version uint
+	dirty   bool
+	stat    PoolStat
+}
+
+func (r *fakeRepository) Ping(ctx context.Context) error {
+	return r.pingErr
+}
+
+func (r *fakeRepository) MigrationVersion(ctx context.Context) (uint, bool, error) {
+	return r.version, r.dirty, nil
+}
+
+func (r *fakeRepository) PoolStat() PoolStat {
+	return r.stat
+}
+
+func ready(repository Repository) (int, map[string]interface{}) {
+	checker := NewChecker(time.Second)
+	checker.Add("database", DatabaseCheck(repository))
+	checker.Add("migrations", MigrationCheck(repository, 15))
+	checker.Add("pool", PoolCheck(repository))
+
+	w := httptest.NewRecorder()
+	MakeReadyHandler(checker).ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	var body map[string]interface{}
+	json.NewDecoder(w.Body).Decode(&body)
+	return w.Code, body
+}
+
+func checkStatus(body map[string]interface{}, name string) interface{} {
+	return body["checks"].(map[string]interface{})[name].(map[string]interface{})["status"]
+}
+
+func TestReady(t *testing.T) {
+	code, body := ready(&fakeRepository{version: 15, stat: PoolStat{AcquiredConns: 1, TotalConns: 2, MaxConns: 4}})
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, "ok", body["status"])
+	pool := body["checks"].(map[string]interface{})["pool"].(map[string]interface{})
+	require.Equal(t, map[string]interface{}{"acquired": 1.0, "idle": 0.0, "total": 2.0, "max": 4.0, "saturation": 0.25}, pool["details"])
+}
+
+func TestReadyWarnsWhenPoolIsSaturated(t *testing.T) {
+	code, body := ready(&fakeRepository{version: 15, stat: PoolStat{AcquiredConns: 4, TotalConns: 4, MaxConns: 4}})
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, "warn", checkStatus(body, "pool"))
+}
+
+func TestNotReadyWhenDatabaseIsDown(t *testing.T) {
+	code, body := ready(&fakeRepository{pingErr: errors.New("connection refused"), version: 15})
+	require.Equal(t, http.StatusServiceUnavailable, code)
+	require.Equal(t, "fail", body["status"])
+	require.Equal(t, "fail", checkStatus(body, "database"))
+	require.Equal(t, "ok", checkStatus(body, "migrations"))
+}
+
+func TestNotReadyWhenSchemaIsNotMigrated(t *testing.T) {
+	code, body := ready(&fakeRepository{version: 14})
+	require.Equal(t, http.StatusServiceUnavailable, code)
+	require.Equal(t, "fail", checkStatus(body, "migrations"))
+
+	code, body = ready(&fakeRepository{version: 15, dirty: true})
+	require.Equal(t, http.StatusServiceUnavailable, code)
+	require.Equal(t, "fail", checkStatus(body, "migrations"))
+}
+
+func TestReadyWhenSchemaIsAhead(t *testing.T) {
+	code, body := ready(&fakeRepository{version: 16})
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, "ok", checkStatus(body, "migrations"))
+}
+
+func TestLive(t *testing.T) {
+	w := httptest.NewRecorder()
+	MakeLiveHandler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/livez", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, `{"status":"ok","checks":{}}`, w.Body.String())
+}
+
+func TestNotReadyWhileDraining(t *testing.T) {
+	checker := NewChecker(time.Second)
+	checker.Add("database", DatabaseCheck(&fakeRepository{}))
+	require.Equal(t, StatusOK, checker.Check(context.Background()).Status)
+
+	checker.Drain()
+	report := checker.Check(context.Background())
+	require.Equal(t, StatusFail, report.Status)
+	require.Equal(t, StatusFail, report.Checks["shutdown"].Status)
+	require.Equal(t, StatusOK, report.Checks["database"].Status)
+}
@@ -0,0 +1,31 @@
+package health
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// MakeLiveHandler serves /livez, which only shows that the process is serving HTTP. It checks no
+// dependencies, so that an outage elsewhere does not get every pod restarted.
+func MakeLiveHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeReport(w, Report{Status: StatusOK, Checks: map[string]Result{}})
+	})
+}
+
+// MakeReadyHandler serves /readyz, answering 503 Service Unavailable if any check fails, so that
+// no traffic is sent to an instance that cannot serve it.
+func MakeReadyHandler(checker *Checker) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeReport(w, checker.Check(r.Context()))
+	})
+}
+
+func writeReport(w http.ResponseWriter, report Report) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	if report.Status == StatusFail {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	json.NewEncoder(w).Encode(report)
+}
@@ -0,0 +1,59 @@
+package health
+
+import (
+	"context"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/pkg/errors"
+)
+
+type Repository interface {
+	Ping(ctx context.Context) error
+	MigrationVersion(ctx context.Context) (uint, bool, error)
+	PoolStat() PoolStat
+}
+
+// PoolStat is the part of the pool's statistics readiness reports.
+type PoolStat struct {
+	AcquiredConns int32 `json:"acquired"`
+	IdleConns     int32 `json:"idle"`
+	TotalConns    int32 `json:"total"`
+	MaxConns      int32 `json:"max"`
+}
+
+func NewRepository(dbPool *pgxpool.Pool) Repository {
+	return &repository{dbPool}
+}
+
+type repository struct {
+	pool *pgxpool.Pool
+}
+
+func (r *repository) Ping(ctx context.Context) error {
+	return errors.Wrap(r.pool.Ping(ctx), "error pinging database")
+}
+
+// MigrationVersion reads the table golang-migrate keeps its version in. Version 0 means no
+// migration has been applied.
+func (r *repository) MigrationVersion(ctx context.Context) (uint, bool, error) {
+	var version int64
+	var dirty bool
+	err := r.pool.QueryRow(ctx, `SELECT version, dirty FROM schema_migrations LIMIT 1`).Scan(&version, &dirty)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, errors.Wrap(err, "error reading migration version")
+	}
+	return uint(version), dirty, nil
+}
+
+func (r *repository) PoolStat() PoolStat {
+	stat := r.pool.Stat()
+	return PoolStat{
+		AcquiredConns: stat.AcquiredConns(),
+		IdleConns:     stat.IdleConns(),
+		TotalConns:    stat.TotalConns(),
+		MaxConns:      stat.MaxConns(),
+	}
+}
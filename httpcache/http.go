@@ -0,0 +1,181 @@
+package httpcache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+	"github.com/rchauhan9/sportech/auth"
+	"github.com/rchauhan9/sportech/commons/go/logging"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Resource is a group of routes whose responses are built from the same tables.
+type Resource struct {
+	Name string
+	// Tables follows the same contract as cache.Listener.Subscribe: usually a package's Tables.
+	Tables []string
+	// Daily marks resources rendered as of today unless asked otherwise, which change at
+	// midnight even when none of their tables do.
+	Daily bool
+}
+
+// MakeHandler wraps next so successful GET and HEAD responses carry ETag, Last-Modified and
+// Cache-Control headers, and conditional requests that still match get 304 Not Modified
+// without running next. Validators come from when the resource's tables last changed rather
+// than from the body, so a 304 costs a single lookup.
+//
+// authorize must allow a request before it is validated; requests it refuses are passed to next,
+// whose endpoints reject them. Responses to requests made with a credential are private, so that
+// shared caches never serve them to anyone else.
+func MakeHandler(next http.Handler, repository Repository, resource Resource, cacheControl string, authorize func(*http.Request) error, logger log.Logger) http.Handler {
+	return &handler{
+		next:         next,
+		repository:   repository,
+		resource:     resource,
+		cacheControl: cacheControl,
+		authorize:    authorize,
+		logger:       logger,
+		now:          time.Now,
+	}
+}
+
+type handler struct {
+	next         http.Handler
+	repository   Repository
+	resource     Resource
+	cacheControl string
+	authorize    func(*http.Request) error
+	logger       log.Logger
+	now          func() time.Time
+}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		h.next.ServeHTTP(w, r)
+		return
+	}
+	if err := h.authorize(r); err != nil {
+		h.next.ServeHTTP(w, r)
+		return
+	}
+	cacheControl := h.cacheControl
+	if auth.CredentialFromContext(r.Context()) != "" {
+		cacheControl = private(cacheControl)
+	}
+
+	version, err := h.repository.LastModified(r.Context(), h.resource.Tables)
+	if err != nil {
+		level.Error(logging.FromContext(r.Context(), h.logger)).Log("resource", h.resource.Name, "err", err)
+		h.next.ServeHTTP(w, r)
+		return
+	}
+	if h.resource.Daily {
+		if today := h.now().UTC().Truncate(24 * time.Hour); today.After(version) {
+			version = today
+		}
+	}
+
+	etag := entityTag(version, r)
+	// HTTP dates only have second precision.
+	lastModified := version.UTC().Truncate(time.Second)
+
+	setHeaders := func(header http.Header) {
+		header.Set("ETag", etag)
+		header.Set("Last-Modified", lastModified.Format(http.TimeFormat))
+		header.Set("Cache-Control", cacheControl)
+		header.Add("Vary", "Accept, Authorization, X-API-Key")
+	}
+
+	if notModified(r, etag, lastModified) {
+		setHeaders(w.Header())
+		w.Header().Del("Content-Type")
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	h.next.ServeHTTP(&cacheWriter{ResponseWriter: w, setHeaders: setHeaders}, r)
+}
+
+// private turns a public Cache-Control value into a private one.
+func private(cacheControl string) string {
+	directives := []string{"private"}
+	for _, directive := range strings.Split(cacheControl, ",") {
+		directive = strings.TrimSpace(directive)
+		if directive != "" && !strings.EqualFold(directive, "public") && !strings.EqualFold(directive, "private") {
+			directives = append(directives, directive)
+		}
+	}
+	return strings.Join(directives, ", ")
+}
+
+// entityTag is a weak validator for the representation of r at version. It covers the URL and
+// Accept header because both choose what is rendered.
+func entityTag(version time.Time, r *http.Request) string {
+	hash := sha256.New()
+	hash.Write([]byte(strconv.FormatInt(version.UnixNano(), 10)))
+	hash.Write([]byte{0})
+	hash.Write([]byte(r.URL.RequestURI()))
+	hash.Write([]byte{0})
+	hash.Write([]byte(r.Header.Get("Accept")))
+	return `W/"` + hex.EncodeToString(hash.Sum(nil))[:32] + `"`
+}
+
+// notModified evaluates If-None-Match, falling back to If-Modified-Since only when it is absent,
+// as RFC 7232 requires.
+func notModified(r *http.Request, etag string, lastModified time.Time) bool {
+	if inm := r.Header.Get("If-None-Match"); inm != "" {
+		for _, candidate := range strings.Split(inm, ",") {
+			candidate = strings.TrimSpace(candidate)
+			if candidate == "*" || strings.TrimPrefix(candidate, "W/") == strings.TrimPrefix(etag, "W/") {
+				return true
+			}
+		}
+		return false
+	}
+	if ims := r.Header.Get("If-Modified-Since"); ims != "" {
+		since, err := http.ParseTime(ims)
+		return err == nil && !lastModified.After(since)
+	}
+	return false
+}
+
+// cacheWriter only adds the cache headers to successful responses, so errors are never cached.
+type cacheWriter struct {
+	http.ResponseWriter
+	setHeaders  func(http.Header)
+	wroteHeader bool
+}
+
+func (w *cacheWriter) WriteHeader(status int) {
+	if !w.wroteHeader {
+		w.wroteHeader = true
+		if status == http.StatusOK {
+			w.setHeaders(w.Header())
+		}
+	}
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *cacheWriter) Write(b []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *cacheWriter) Flush() {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+func (w *cacheWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
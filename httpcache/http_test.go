@@ -0,0 +1,195 @@
+package httpcache
+
+import (
+	"context"
+	"github.com/go-kit/log"
+	"github.com/pkg/errors"
+	"github.com/rchauhan9/sportech/auth"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+type fakeRepository struct {
+	lastModified time.Time
+	err          error
+	tables       []string
+}
+
+func (f *fakeRepository) LastModified(_ context.Context, tables []string) (time.Time, error) {
+	f.tables = tables
+	return f.lastModified, f.err
+}
+
+func newTestHandler(repository Repository, resource Resource, served *int) *handler {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*served++
+		if r.URL.Query().Get("fail") != "" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Write([]byte(`{"teams":[]}`))
+	})
+	authorize := func(r *http.Request) error {
+		if auth.CredentialFromContext(r.Context()) == "revoked" {
+			return auth.ErrUnauthenticated
+		}
+		return nil
+	}
+	h := MakeHandler(next, repository, resource, "public, max-age=300", authorize, log.NewNopLogger()).(*handler)
+	h.now = func() time.Time { return time.Date(2022, time.March, 1, 12, 0, 0, 0, time.UTC) }
+	return h
+}
+
+func TestSetsValidatorsOnSuccess(t *testing.T) {
+	repository := &fakeRepository{lastModified: time.Date(2022, time.February, 20, 9, 30, 15, 500, time.UTC)}
+	served := 0
+	h := newTestHandler(repository, Resource{Name: "teams", Tables: []string{"teams", "team_names"}}, &served)
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/teams/", nil))
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, 1, served)
+	require.Equal(t, []string{"teams", "team_names"}, repository.tables)
+	require.NotEmpty(t, w.Header().Get("ETag"))
+	require.Equal(t, "Sun, 20 Feb 2022 09:30:15 GMT", w.Header().Get("Last-Modified"))
+	require.Equal(t, "public, max-age=300", w.Header().Get("Cache-Control"))
+	require.Equal(t, "Accept, Authorization, X-API-Key", w.Header().Get("Vary"))
+}
+
+func TestCredentialedResponsesArePrivate(t *testing.T) {
+	repository := &fakeRepository{lastModified: time.Date(2022, time.February, 20, 9, 30, 15, 0, time.UTC)}
+	served := 0
+	h := newTestHandler(repository, Resource{Name: "teams"}, &served)
+
+	r := httptest.NewRequest(http.MethodGet, "/teams/", nil)
+	r = r.WithContext(auth.WithCredential(r.Context(), "spt_key"))
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "private, max-age=300", w.Header().Get("Cache-Control"))
+}
+
+func TestUnauthorizedRequestsAreNotValidated(t *testing.T) {
+	repository := &fakeRepository{lastModified: time.Date(2022, time.February, 20, 9, 30, 15, 0, time.UTC)}
+	served := 0
+	h := newTestHandler(repository, Resource{Name: "teams"}, &served)
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/teams/", nil))
+	etag := w.Header().Get("ETag")
+
+	r := httptest.NewRequest(http.MethodGet, "/teams/", nil)
+	r = r.WithContext(auth.WithCredential(r.Context(), "revoked"))
+	r.Header.Set("If-None-Match", etag)
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Empty(t, w.Header().Get("ETag"))
+	require.Equal(t, 2, served)
+}
+
+func TestNotModified(t *testing.T) {
+	repository := &fakeRepository{lastModified: time.Date(2022, time.February, 20, 9, 30, 15, 0, time.UTC)}
+	served := 0
+	h := newTestHandler(repository, Resource{Name: "teams"}, &served)
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/teams/", nil))
+	etag := w.Header().Get("ETag")
+
+	r := httptest.NewRequest(http.MethodGet, "/teams/", nil)
+	r.Header.Set("If-None-Match", `W/"stale", `+etag)
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	require.Equal(t, http.StatusNotModified, w.Code)
+	require.Equal(t, etag, w.Header().Get("ETag"))
+	require.Empty(t, w.Body.String())
+
+	r = httptest.NewRequest(http.MethodGet, "/teams/", nil)
+	r.Header.Set("If-Modified-Since", "Sun, 20 Feb 2022 09:30:15 GMT")
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	require.Equal(t, http.StatusNotModified, w.Code)
+
+	require.Equal(t, 1, served)
+}
+
+func TestModified(t *testing.T) {
+	repository := &fakeRepository{lastModified: time.Date(2022, time.February, 20, 9, 30, 15, 0, time.UTC)}
+	served := 0
+	h := newTestHandler(repository, Resource{Name: "teams"}, &served)
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/teams/", nil))
+	etag := w.Header().Get("ETag")
+
+	// A different representation of the same data has its own tag.
+	r := httptest.NewRequest(http.MethodGet, "/teams/", nil)
+	r.Header.Set("Accept", "text/csv")
+	r.Header.Set("If-None-Match", etag)
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	repository.lastModified = repository.lastModified.Add(time.Millisecond)
+	r = httptest.NewRequest(http.MethodGet, "/teams/", nil)
+	r.Header.Set("If-None-Match", etag)
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.NotEqual(t, etag, w.Header().Get("ETag"))
+
+	// If-None-Match wins over If-Modified-Since.
+	r = httptest.NewRequest(http.MethodGet, "/teams/", nil)
+	r.Header.Set("If-None-Match", etag)
+	r.Header.Set("If-Modified-Since", "Mon, 21 Feb 2022 00:00:00 GMT")
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	require.Equal(t, 4, served)
+}
+
+func TestDailyResourcesChangeAtMidnight(t *testing.T) {
+	repository := &fakeRepository{lastModified: time.Date(2022, time.February, 20, 9, 30, 15, 0, time.UTC)}
+	served := 0
+	h := newTestHandler(repository, Resource{Name: "teams", Daily: true}, &served)
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/teams/", nil))
+	require.Equal(t, "Tue, 01 Mar 2022 00:00:00 GMT", w.Header().Get("Last-Modified"))
+}
+
+func TestErrorsAreNotCached(t *testing.T) {
+	served := 0
+	h := newTestHandler(&fakeRepository{}, Resource{Name: "teams"}, &served)
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/teams/?fail=1", nil))
+	require.Equal(t, http.StatusInternalServerError, w.Code)
+	require.Empty(t, w.Header().Get("ETag"))
+	require.Empty(t, w.Header().Get("Cache-Control"))
+
+	h = newTestHandler(&fakeRepository{err: errors.New("connection refused")}, Resource{Name: "teams"}, &served)
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/teams/", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Empty(t, w.Header().Get("ETag"))
+}
+
+func TestWritesAreNotCached(t *testing.T) {
+	served := 0
+	h := newTestHandler(&fakeRepository{}, Resource{Name: "leagues"}, &served)
+
+	r := httptest.NewRequest(http.MethodPost, "/countries/eng/end-season", nil)
+	r.Header.Set("If-None-Match", "*")
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Empty(t, w.Header().Get("ETag"))
+	require.Equal(t, 1, served)
+}
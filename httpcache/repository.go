@@ -0,0 +1,35 @@
+package httpcache
+
+import (
+	"context"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/pkg/errors"
+	"time"
+)
+
+type Repository interface {
+	LastModified(ctx context.Context, tables []string) (time.Time, error)
+}
+
+func NewRepository(dbPool *pgxpool.Pool) Repository {
+	return &repository{dbPool}
+}
+
+type repository struct {
+	pool *pgxpool.Pool
+}
+
+// LastModified is the latest time any of tables had a row inserted, updated or deleted, as
+// recorded in table_versions by a statement trigger on each table.
+func (r *repository) LastModified(ctx context.Context, tables []string) (time.Time, error) {
+	query := `
+		SELECT COALESCE(MAX(updated_at), 'epoch')
+		FROM table_versions
+		WHERE table_name = ANY($1)
+	`
+	var lastModified time.Time
+	if err := r.pool.QueryRow(ctx, query, tables).Scan(&lastModified); err != nil {
+		return time.Time{}, errors.Wrap(err, "error fetching table versions from database")
+	}
+	return lastModified, nil
+}
@@ -0,0 +1,181 @@
+package httpsecurity
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/pkg/errors"
+)
+
+// Policy says which cross-origin requests browsers may make. AllowedOrigins lists origins such as
+// "https://example.com", or "*" for any origin. AllowedHeaders are the request headers callers may
+// set, and ExposedHeaders the response headers their scripts may read. Browsers may cache the
+// answer to a preflight request for MaxAge.
+type Policy struct {
+	AllowedOrigins   []string
+	AllowedMethods   []string
+	AllowedHeaders   []string
+	ExposedHeaders   []string
+	AllowCredentials bool
+	MaxAge           time.Duration
+}
+
+func (p Policy) Validate() error {
+	if p.AllowCredentials && p.anyOrigin() {
+		return errors.New("cors: credentials cannot be allowed from any origin")
+	}
+	if p.MaxAge < 0 {
+		return errors.New("cors: max age must not be negative")
+	}
+	return nil
+}
+
+func (p Policy) anyOrigin() bool {
+	return containsFold(p.AllowedOrigins, "*")
+}
+
+func (p Policy) allowsOrigin(origin string) bool {
+	return p.anyOrigin() || containsFold(p.AllowedOrigins, origin)
+}
+
+// Routes maps each path the API serves, such as "/teams/{id}", to the methods it accepts.
+type Routes map[string][]string
+
+// RoutesFromSpec lists the routes in the OpenAPI document.
+func RoutesFromSpec(spec *openapi3.T) Routes {
+	routes := Routes{}
+	for path, item := range spec.Paths {
+		for method := range item.Operations() {
+			routes[path] = append(routes[path], method)
+		}
+		sort.Strings(routes[path])
+	}
+	return routes
+}
+
+// match finds the methods accepted at path, where a segment such as {id} in a route matches any
+// non-empty segment.
+func (r Routes) match(path string) ([]string, bool) {
+	if methods, ok := r[path]; ok {
+		return methods, true
+	}
+	segments := strings.Split(path, "/")
+	for route, methods := range r {
+		routeSegments := strings.Split(route, "/")
+		if len(routeSegments) != len(segments) {
+			continue
+		}
+		matched := true
+		for i, s := range routeSegments {
+			variable := strings.HasPrefix(s, "{") && strings.HasSuffix(s, "}")
+			if (variable && segments[i] == "") || (!variable && s != segments[i]) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return methods, true
+		}
+	}
+	return nil, false
+}
+
+// MakeCORSHandler wraps next, usually the whole mux, to apply policy. OPTIONS requests are
+// answered here for the routes in routes: a preflight request is only approved if its origin,
+// method and headers are all allowed for the route it asks about, and OPTIONS requests for
+// unknown routes are not found.
+func MakeCORSHandler(next http.Handler, policy Policy, routes Routes) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header := w.Header()
+		origin := r.Header.Get("Origin")
+		if r.Method == http.MethodOptions {
+			methods, ok := routes.match(r.URL.Path)
+			if !ok {
+				http.NotFound(w, r)
+				return
+			}
+			requestedMethod := r.Header.Get("Access-Control-Request-Method")
+			header.Set("Allow", strings.Join(methods, ", ")+", "+http.MethodOptions)
+			if origin == "" || requestedMethod == "" {
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+			preflight(w, r, policy, methods, origin, requestedMethod)
+			return
+		}
+
+		if origin != "" {
+			if !policy.anyOrigin() || policy.AllowCredentials {
+				header.Add("Vary", "Origin")
+			}
+			if policy.allowsOrigin(origin) {
+				setAllowOrigin(header, policy, origin)
+				if len(policy.ExposedHeaders) > 0 {
+					header.Set("Access-Control-Expose-Headers", strings.Join(policy.ExposedHeaders, ", "))
+				}
+			}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func preflight(w http.ResponseWriter, r *http.Request, policy Policy, methods []string, origin string, requestedMethod string) {
+	header := w.Header()
+	header.Add("Vary", "Origin, Access-Control-Request-Method, Access-Control-Request-Headers")
+	if !policy.allowsOrigin(origin) {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+	var allowed []string
+	for _, method := range methods {
+		if containsFold(policy.AllowedMethods, method) {
+			allowed = append(allowed, method)
+		}
+	}
+	if !containsFold(allowed, requestedMethod) {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	for _, h := range strings.Split(r.Header.Get("Access-Control-Request-Headers"), ",") {
+		if h = strings.TrimSpace(h); h != "" && !containsFold(policy.AllowedHeaders, h) {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+	}
+
+	setAllowOrigin(header, policy, origin)
+	header.Set("Access-Control-Allow-Methods", strings.Join(allowed, ", "))
+	if len(policy.AllowedHeaders) > 0 {
+		header.Set("Access-Control-Allow-Headers", strings.Join(policy.AllowedHeaders, ", "))
+	}
+	if policy.MaxAge > 0 {
+		header.Set("Access-Control-Max-Age", strconv.Itoa(int(policy.MaxAge.Seconds())))
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// setAllowOrigin allows origin. Any origin is allowed with "*", unless credentials are allowed,
+// which browsers only accept alongside the caller's own origin.
+func setAllowOrigin(header http.Header, policy Policy, origin string) {
+	if policy.anyOrigin() && !policy.AllowCredentials {
+		header.Set("Access-Control-Allow-Origin", "*")
+		return
+	}
+	header.Set("Access-Control-Allow-Origin", origin)
+	if policy.AllowCredentials {
+		header.Set("Access-Control-Allow-Credentials", "true")
+	}
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
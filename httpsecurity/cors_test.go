@@ -0,0 +1,107 @@
+package httpsecurity
+
+import (
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+var routes = Routes{
+	"/teams/":             {http.MethodGet},
+	"/teams/{id}":         {http.MethodGet},
+	"/persons/{id}/merge": {http.MethodPost},
+}
+
+var policy = Policy{
+	AllowedOrigins: []string{"https://app.example.com"},
+	AllowedMethods: []string{http.MethodGet, http.MethodPost},
+	AllowedHeaders: []string{"Content-Type", "X-API-Key"},
+	ExposedHeaders: []string{"ETag", "X-Request-ID"},
+	MaxAge:         10 * time.Minute,
+}
+
+func serve(policy Policy, r *http.Request) *httptest.ResponseRecorder {
+	handler := MakeCORSHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("{}"))
+	}), policy, routes)
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	return w
+}
+
+func preflightRequest(path string, origin string, method string, headers string) *http.Request {
+	r := httptest.NewRequest(http.MethodOptions, path, nil)
+	r.Header.Set("Origin", origin)
+	r.Header.Set("Access-Control-Request-Method", method)
+	if headers != "" {
+		r.Header.Set("Access-Control-Request-Headers", headers)
+	}
+	return r
+}
+
+func TestPreflight(t *testing.T) {
+	w := serve(policy, preflightRequest("/teams/7", "https://app.example.com", http.MethodGet, "x-api-key"))
+	require.Equal(t, http.StatusNoContent, w.Code)
+	require.Equal(t, "https://app.example.com", w.Header().Get("Access-Control-Allow-Origin"))
+	require.Equal(t, "GET", w.Header().Get("Access-Control-Allow-Methods"))
+	require.Equal(t, "Content-Type, X-API-Key", w.Header().Get("Access-Control-Allow-Headers"))
+	require.Equal(t, "600", w.Header().Get("Access-Control-Max-Age"))
+	require.Empty(t, w.Header().Get("Access-Control-Allow-Credentials"))
+}
+
+func TestPreflightRejections(t *testing.T) {
+	for name, test := range map[string]struct {
+		r    *http.Request
+		code int
+	}{
+		"unknown route":      {preflightRequest("/nowhere", "https://app.example.com", http.MethodGet, ""), http.StatusNotFound},
+		"unknown origin":     {preflightRequest("/teams/", "https://evil.example.com", http.MethodGet, ""), http.StatusForbidden},
+		"method not routed":  {preflightRequest("/teams/", "https://app.example.com", http.MethodPost, ""), http.StatusMethodNotAllowed},
+		"header not allowed": {preflightRequest("/teams/", "https://app.example.com", http.MethodGet, "X-Secret"), http.StatusForbidden},
+	} {
+		w := serve(policy, test.r)
+		require.Equal(t, test.code, w.Code, name)
+		require.Empty(t, w.Header().Get("Access-Control-Allow-Origin"), name)
+	}
+}
+
+func TestOptionsWithoutPreflight(t *testing.T) {
+	w := serve(policy, httptest.NewRequest(http.MethodOptions, "/persons/1/merge", nil))
+	require.Equal(t, http.StatusNoContent, w.Code)
+	require.Equal(t, "POST, OPTIONS", w.Header().Get("Allow"))
+}
+
+func TestCrossOriginRequests(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/teams/", nil)
+	r.Header.Set("Origin", "https://app.example.com")
+	w := serve(policy, r)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "https://app.example.com", w.Header().Get("Access-Control-Allow-Origin"))
+	require.Equal(t, "ETag, X-Request-ID", w.Header().Get("Access-Control-Expose-Headers"))
+	require.Equal(t, "Origin", w.Header().Get("Vary"))
+
+	r.Header.Set("Origin", "https://evil.example.com")
+	w = serve(policy, r)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Empty(t, w.Header().Get("Access-Control-Allow-Origin"))
+
+	anyOrigin := policy
+	anyOrigin.AllowedOrigins = []string{"*"}
+	w = serve(anyOrigin, r)
+	require.Equal(t, "*", w.Header().Get("Access-Control-Allow-Origin"))
+	require.Empty(t, w.Header().Get("Vary"))
+
+	withCredentials := policy
+	withCredentials.AllowCredentials = true
+	r.Header.Set("Origin", "https://app.example.com")
+	w = serve(withCredentials, r)
+	require.Equal(t, "https://app.example.com", w.Header().Get("Access-Control-Allow-Origin"))
+	require.Equal(t, "true", w.Header().Get("Access-Control-Allow-Credentials"))
+}
+
+func TestPolicyValidate(t *testing.T) {
+	require.NoError(t, policy.Validate())
+	require.Error(t, Policy{AllowedOrigins: []string{"*"}, AllowCredentials: true}.Validate())
+}
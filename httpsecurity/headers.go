@@ -0,0 +1,45 @@
+package httpsecurity
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Headers are the security headers sent with every response. Strict-Transport-Security is only
+// sent when HSTSMaxAge is set, as it should only be where the API is served over HTTPS, and the
+// other headers are left out when empty. X-Content-Type-Options: nosniff is always sent.
+type Headers struct {
+	HSTSMaxAge            time.Duration
+	HSTSIncludeSubdomains bool
+	FrameOptions          string
+	ReferrerPolicy        string
+	ContentSecurityPolicy string
+}
+
+func MakeHeadersHandler(next http.Handler, headers Headers) http.Handler {
+	hsts := ""
+	if headers.HSTSMaxAge > 0 {
+		hsts = "max-age=" + strconv.Itoa(int(headers.HSTSMaxAge.Seconds()))
+		if headers.HSTSIncludeSubdomains {
+			hsts += "; includeSubDomains"
+		}
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header := w.Header()
+		header.Set("X-Content-Type-Options", "nosniff")
+		if hsts != "" {
+			header.Set("Strict-Transport-Security", hsts)
+		}
+		if headers.FrameOptions != "" {
+			header.Set("X-Frame-Options", headers.FrameOptions)
+		}
+		if headers.ReferrerPolicy != "" {
+			header.Set("Referrer-Policy", headers.ReferrerPolicy)
+		}
+		if headers.ContentSecurityPolicy != "" {
+			header.Set("Content-Security-Policy", headers.ContentSecurityPolicy)
+		}
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,30 @@
+package httpsecurity
+
+import (
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestSecurityHeaders(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+
+	w := httptest.NewRecorder()
+	MakeHeadersHandler(next, Headers{}).ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/teams/", nil))
+	require.Equal(t, "nosniff", w.Header().Get("X-Content-Type-Options"))
+	require.Empty(t, w.Header().Get("Strict-Transport-Security"))
+	require.Empty(t, w.Header().Get("X-Frame-Options"))
+
+	w = httptest.NewRecorder()
+	MakeHeadersHandler(next, Headers{
+		HSTSMaxAge:            365 * 24 * time.Hour,
+		HSTSIncludeSubdomains: true,
+		FrameOptions:          "DENY",
+		ReferrerPolicy:        "no-referrer",
+	}).ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/teams/", nil))
+	require.Equal(t, "max-age=31536000; includeSubDomains", w.Header().Get("Strict-Transport-Security"))
+	require.Equal(t, "DENY", w.Header().Get("X-Frame-Options"))
+	require.Equal(t, "no-referrer", w.Header().Get("Referrer-Policy"))
+}
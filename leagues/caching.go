@@ -0,0 +1,55 @@
+package leagues
+
+import (
+	"context"
+	"github.com/rchauhan9/sportech/cache"
+	"github.com/rchauhan9/sportech/stream"
+)
+
+// NewCachingService serves reads from store, falling through to next on a miss. Ending a season
+// purges the store straight away rather than waiting for the change notification.
+func NewCachingService(next Service, store *cache.Store) Service {
+	return &cachingService{next: next, store: store}
+}
+
+type cachingService struct {
+	next  Service
+	store *cache.Store
+}
+
+func (s *cachingService) ListLeagues(ctx context.Context) ([]League, error) {
+	return cache.Load(s.store, cache.Key("ListLeagues"), func() ([]League, error) {
+		return s.next.ListLeagues(ctx)
+	})
+}
+
+func (s *cachingService) StreamLeagues(ctx context.Context, yield func(League) error) error {
+	leagues, err := s.ListLeagues(ctx)
+	if err != nil {
+		return err
+	}
+	return stream.Slice(leagues)(ctx, yield)
+}
+
+func (s *cachingService) GetLeague(ctx context.Context, id string) (League, error) {
+	return cache.Load(s.store, cache.Key("GetLeague", id), func() (League, error) {
+		return s.next.GetLeague(ctx, id)
+	})
+}
+
+func (s *cachingService) GetLeagues(ctx context.Context, ids []string) (map[string]League, error) {
+	return cache.LoadEach(s.store, "GetLeague", ids, func(missing []string) (map[string]League, error) {
+		return s.next.GetLeagues(ctx, missing)
+	})
+}
+
+func (s *cachingService) GetPyramid(ctx context.Context, countryID string) (Pyramid, error) {
+	return cache.Load(s.store, cache.Key("GetPyramid", countryID), func() (Pyramid, error) {
+		return s.next.GetPyramid(ctx, countryID)
+	})
+}
+
+func (s *cachingService) EndSeason(ctx context.Context, countryID string, season int32, playoffWinners map[string]string) ([]Movement, error) {
+	defer s.store.Purge()
+	return s.next.EndSeason(ctx, countryID, season, playoffWinners)
+}
@@ -3,12 +3,16 @@ package leagues
 import (
 	"context"
 	"github.com/go-kit/kit/endpoint"
+	"github.com/rchauhan9/sportech/stream"
 )
 
-type listLeaguesRequest struct{}
+type listLeaguesRequest struct {
+	Format string
+}
 
 type listLeaguesResponse struct {
-	Leagues []League `json:"leagues"`
+	Leagues stream.Stream[League] `json:"leagues"`
+	format  string
 }
 
 type getLeagueRequest struct {
@@ -19,11 +23,28 @@ type getLeagueResponse struct {
 	League League `json:"league"`
 }
 
+type getPyramidRequest struct {
+	CountryID string
+}
+
+type getPyramidResponse struct {
+	Pyramid Pyramid `json:"pyramid"`
+}
+
+type endSeasonRequest struct {
+	CountryID      string            `json:"-"`
+	Season         int32             `json:"season"`
+	PlayoffWinners map[string]string `json:"playoffWinners"`
+}
+
+type endSeasonResponse struct {
+	Movements []Movement `json:"movements"`
+}
+
 func MakeListLeaguesEndpoint(svc Service) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
-		_ = request.(listLeaguesRequest)
-		leagues, err := svc.ListLeagues(ctx)
-		return listLeaguesResponse{Leagues: leagues}, err
+		req := request.(listLeaguesRequest)
+		return listLeaguesResponse{Leagues: svc.StreamLeagues, format: req.Format}, nil
 	}
 }
 
@@ -34,3 +55,19 @@ func MakeGetLeagueEndpoint(svc Service) endpoint.Endpoint {
 		return getLeagueResponse{League: league}, err
 	}
 }
+
+func MakeGetPyramidEndpoint(svc Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(getPyramidRequest)
+		pyramid, err := svc.GetPyramid(ctx, req.CountryID)
+		return getPyramidResponse{Pyramid: pyramid}, err
+	}
+}
+
+func MakeEndSeasonEndpoint(svc Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(endSeasonRequest)
+		movements, err := svc.EndSeason(ctx, req.CountryID, req.Season, req.PlayoffWinners)
+		return endSeasonResponse{Movements: movements}, err
+	}
+}
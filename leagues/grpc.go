@@ -0,0 +1,185 @@
+package leagues
+
+import (
+	"context"
+	"github.com/go-kit/kit/endpoint"
+	grpctransport "github.com/go-kit/kit/transport/grpc"
+	"github.com/pkg/errors"
+	"github.com/rchauhan9/sportech/auth"
+	pb "github.com/rchauhan9/sportech/pb/sportech/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type grpcServer struct {
+	pb.UnimplementedLeagueServiceServer
+	listLeagues grpctransport.Handler
+	getLeague   grpctransport.Handler
+	getPyramid  grpctransport.Handler
+	endSeason   grpctransport.Handler
+}
+
+func MakeGRPCServer(listLeaguesEndpoint endpoint.Endpoint, getLeagueEndpoint endpoint.Endpoint, getPyramidEndpoint endpoint.Endpoint, endSeasonEndpoint endpoint.Endpoint) pb.LeagueServiceServer {
+	return &grpcServer{
+		listLeagues: grpctransport.NewServer(
+			listLeaguesEndpoint,
+			decodeGRPCListLeaguesRequest,
+			encodeGRPCListLeaguesResponse,
+		),
+		getLeague: grpctransport.NewServer(
+			getLeagueEndpoint,
+			decodeGRPCGetLeagueRequest,
+			encodeGRPCGetLeagueResponse,
+		),
+		getPyramid: grpctransport.NewServer(
+			getPyramidEndpoint,
+			decodeGRPCGetPyramidRequest,
+			encodeGRPCGetPyramidResponse,
+		),
+		endSeason: grpctransport.NewServer(
+			endSeasonEndpoint,
+			decodeGRPCEndSeasonRequest,
+			encodeGRPCEndSeasonResponse,
+		),
+	}
+}
+
+func (s *grpcServer) ListLeagues(ctx context.Context, req *pb.ListLeaguesRequest) (*pb.ListLeaguesResponse, error) {
+	_, rep, err := s.listLeagues.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeGRPCError(err)
+	}
+	return rep.(*pb.ListLeaguesResponse), nil
+}
+
+func (s *grpcServer) GetLeague(ctx context.Context, req *pb.GetLeagueRequest) (*pb.GetLeagueResponse, error) {
+	_, rep, err := s.getLeague.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeGRPCError(err)
+	}
+	return rep.(*pb.GetLeagueResponse), nil
+}
+
+func (s *grpcServer) GetPyramid(ctx context.Context, req *pb.GetPyramidRequest) (*pb.GetPyramidResponse, error) {
+	_, rep, err := s.getPyramid.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeGRPCError(err)
+	}
+	return rep.(*pb.GetPyramidResponse), nil
+}
+
+func (s *grpcServer) EndSeason(ctx context.Context, req *pb.EndSeasonRequest) (*pb.EndSeasonResponse, error) {
+	_, rep, err := s.endSeason.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeGRPCError(err)
+	}
+	return rep.(*pb.EndSeasonResponse), nil
+}
+
+func decodeGRPCListLeaguesRequest(_ context.Context, _ interface{}) (interface{}, error) {
+	return listLeaguesRequest{}, nil
+}
+
+func encodeGRPCListLeaguesResponse(ctx context.Context, response interface{}) (interface{}, error) {
+	resp := response.(listLeaguesResponse)
+	leagues, err := resp.Leagues.Collect(ctx)
+	if err != nil {
+		return nil, encodeGRPCError(err)
+	}
+	return &pb.ListLeaguesResponse{Leagues: encodeGRPCLeagues(leagues)}, nil
+}
+
+func decodeGRPCGetLeagueRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.GetLeagueRequest)
+	return getLeagueRequest{ID: req.Id}, nil
+}
+
+func encodeGRPCGetLeagueResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(getLeagueResponse)
+	return &pb.GetLeagueResponse{League: encodeGRPCLeague(resp.League)}, nil
+}
+
+func decodeGRPCGetPyramidRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.GetPyramidRequest)
+	return getPyramidRequest{CountryID: req.CountryId}, nil
+}
+
+func encodeGRPCGetPyramidResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(getPyramidResponse)
+	tiers := make([]*pb.Tier, len(resp.Pyramid.Tiers))
+	for i, tier := range resp.Pyramid.Tiers {
+		tiers[i] = &pb.Tier{Level: tier.Level, Leagues: encodeGRPCLeagues(tier.Leagues)}
+	}
+	links := make([]*pb.Link, len(resp.Pyramid.Links))
+	for i, link := range resp.Pyramid.Links {
+		links[i] = &pb.Link{
+			Id:               link.ID,
+			UpperLeague:      link.UpperLeague,
+			LowerLeague:      link.LowerLeague,
+			PromotionPlaces:  link.PromotionPlaces,
+			RelegationPlaces: link.RelegationPlaces,
+			PlayoffPlaces:    link.PlayoffPlaces,
+		}
+	}
+	return &pb.GetPyramidResponse{
+		Pyramid: &pb.Pyramid{Country: resp.Pyramid.Country, Tiers: tiers, Links: links},
+	}, nil
+}
+
+func decodeGRPCEndSeasonRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.EndSeasonRequest)
+	if req.Season == 0 {
+		return nil, errors.Wrap(ErrInvalidArgument, "season is required")
+	}
+	return endSeasonRequest{
+		CountryID:      req.CountryId,
+		Season:         req.Season,
+		PlayoffWinners: req.PlayoffWinners,
+	}, nil
+}
+
+func encodeGRPCEndSeasonResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(endSeasonResponse)
+	movements := make([]*pb.Movement, len(resp.Movements))
+	for i, movement := range resp.Movements {
+		movements[i] = &pb.Movement{
+			Team:       movement.Team,
+			FromLeague: movement.FromLeague,
+			ToLeague:   movement.ToLeague,
+			Reason:     movement.Reason,
+		}
+	}
+	return &pb.EndSeasonResponse{Movements: movements}, nil
+}
+
+func encodeGRPCLeagues(leagues []League) []*pb.League {
+	pbLeagues := make([]*pb.League, len(leagues))
+	for i := range leagues {
+		pbLeagues[i] = encodeGRPCLeague(leagues[i])
+	}
+	return pbLeagues
+}
+
+func encodeGRPCLeague(league League) *pb.League {
+	return &pb.League{
+		Id:            league.ID,
+		Name:          league.Name,
+		NumberOfTeams: league.NumberOfTeams,
+		Country:       league.Country,
+		Tier:          league.Tier,
+	}
+}
+
+// encode errors from business-logic as gRPC statuses
+func encodeGRPCError(err error) error {
+	switch errors.Cause(err) {
+	case ErrInvalidArgument:
+		return status.Error(codes.InvalidArgument, err.Error())
+	case auth.ErrUnauthenticated:
+		return status.Error(codes.Unauthenticated, err.Error())
+	case auth.ErrForbidden:
+		return status.Error(codes.PermissionDenied, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
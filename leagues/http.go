@@ -7,33 +7,63 @@ import (
 	kithttp "github.com/go-kit/kit/transport/http"
 	"github.com/gorilla/mux"
 	"github.com/pkg/errors"
+	"github.com/rchauhan9/sportech/auth"
+	"github.com/rchauhan9/sportech/commons/go/logging"
+	"github.com/rchauhan9/sportech/commons/go/queryutil"
+	"github.com/rchauhan9/sportech/stream"
 	"net/http"
 )
 
-func MakeHandler(listLeaguesEndpoint endpoint.Endpoint, getLeagueEndpoint endpoint.Endpoint) http.Handler {
+func MakeHandler(
+	listLeaguesEndpoint endpoint.Endpoint,
+	getLeagueEndpoint endpoint.Endpoint,
+	getPyramidEndpoint endpoint.Endpoint,
+	endSeasonEndpoint endpoint.Endpoint,
+) http.Handler {
 	r := mux.NewRouter()
 
 	listLeaguesHandler := kithttp.NewServer(
 		listLeaguesEndpoint,
 		decodeListLeaguesRequest,
 		encodeListLeaguesResponse,
+		kithttp.ServerErrorEncoder(encodeError),
 	)
 
 	getLeagueHandler := kithttp.NewServer(
 		getLeagueEndpoint,
 		decodeGetLeagueRequest,
 		encodeGetLeagueResponse,
+		kithttp.ServerErrorEncoder(encodeError),
+	)
+
+	getPyramidHandler := kithttp.NewServer(
+		getPyramidEndpoint,
+		decodeGetPyramidRequest,
+		encodeGetPyramidResponse,
+		kithttp.ServerErrorEncoder(encodeError),
+	)
+
+	endSeasonHandler := kithttp.NewServer(
+		endSeasonEndpoint,
+		decodeEndSeasonRequest,
+		encodeEndSeasonResponse,
+		kithttp.ServerErrorEncoder(encodeError),
 	)
 
 	r.Handle("/leagues/{id}", getLeagueHandler).Methods("GET")
 	r.Handle("/leagues/", listLeaguesHandler).Methods("GET")
+	r.Handle("/countries/{id}/pyramid", getPyramidHandler).Methods("GET")
+	r.Handle("/countries/{id}/pyramid/end-of-season", endSeasonHandler).Methods("POST")
 
 	return r
 }
 
 func decodeListLeaguesRequest(_ context.Context, r *http.Request) (interface{}, error) {
-	_ = mux.Vars(r)
-	return listLeaguesRequest{}, nil
+	format, err := queryutil.Format(r, ErrInvalidArgument)
+	if err != nil {
+		return nil, err
+	}
+	return listLeaguesRequest{Format: format}, nil
 }
 
 func encodeListLeaguesResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
@@ -41,7 +71,8 @@ func encodeListLeaguesResponse(ctx context.Context, w http.ResponseWriter, respo
 		encodeError(ctx, e.error(), w)
 		return nil
 	}
-	return json.NewEncoder(w).Encode(response)
+	resp := response.(listLeaguesResponse)
+	return stream.Encode(ctx, w, resp.format, "leagues", resp.Leagues)
 }
 
 func decodeGetLeagueRequest(_ context.Context, r *http.Request) (interface{}, error) {
@@ -61,18 +92,63 @@ func encodeGetLeagueResponse(ctx context.Context, w http.ResponseWriter, respons
 	return json.NewEncoder(w).Encode(response)
 }
 
+func decodeGetPyramidRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	vars := mux.Vars(r)
+	id, ok := vars["id"]
+	if !ok {
+		return nil, errors.New("bad route")
+	}
+	return getPyramidRequest{CountryID: id}, nil
+}
+
+func encodeGetPyramidResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	if e, ok := response.(errorer); ok && e.error() != nil {
+		encodeError(ctx, e.error(), w)
+		return nil
+	}
+	return json.NewEncoder(w).Encode(response)
+}
+
+func decodeEndSeasonRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	vars := mux.Vars(r)
+	id, ok := vars["id"]
+	if !ok {
+		return nil, errors.New("bad route")
+	}
+	var req endSeasonRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, errors.Wrapf(ErrInvalidArgument, "invalid request body: %s", err)
+	}
+	if req.Season == 0 {
+		return nil, errors.Wrap(ErrInvalidArgument, "season is required")
+	}
+	req.CountryID = id
+	return req, nil
+}
+
+func encodeEndSeasonResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	if e, ok := response.(errorer); ok && e.error() != nil {
+		encodeError(ctx, e.error(), w)
+		return nil
+	}
+	return json.NewEncoder(w).Encode(response)
+}
+
 type errorer interface {
 	error() error
 }
 
 // encode errors from business-logic
-func encodeError(_ context.Context, err error, w http.ResponseWriter) {
+func encodeError(ctx context.Context, err error, w http.ResponseWriter) {
+	logging.RecordError(ctx, err)
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
-	switch err {
-	//case erro:
-	//	w.WriteHeader(http.StatusNotFound)
-	//case ErrInvalidArgument:
-	//	w.WriteHeader(http.StatusBadRequest)
+	switch errors.Cause(err) {
+	case ErrInvalidArgument:
+		w.WriteHeader(http.StatusBadRequest)
+	case auth.ErrUnauthenticated:
+		w.WriteHeader(http.StatusUnauthorized)
+	case auth.ErrForbidden:
+		w.WriteHeader(http.StatusForbidden)
 	default:
 		w.WriteHeader(http.StatusInternalServerError)
 	}
@@ -5,4 +5,48 @@ type League struct {
 	Name          string `json:"name"`
 	NumberOfTeams int32  `json:"numberOfTeams"`
 	Country       string `json:"country"`
+	Tier          *int32 `json:"tier"`
+}
+
+// Link connects two adjacent leagues in a pyramid. PromotionPlaces teams go up automatically,
+// the next PlayoffPlaces teams play off for one further promotion spot, and RelegationPlaces
+// teams come down.
+type Link struct {
+	ID               string `json:"id"`
+	UpperLeague      string `json:"upperLeague"`
+	LowerLeague      string `json:"lowerLeague"`
+	PromotionPlaces  int32  `json:"promotionPlaces"`
+	RelegationPlaces int32  `json:"relegationPlaces"`
+	PlayoffPlaces    int32  `json:"playoffPlaces"`
+}
+
+type Tier struct {
+	Level   int32    `json:"level"`
+	Leagues []League `json:"leagues"`
+}
+
+type Pyramid struct {
+	Country string `json:"country"`
+	Tiers   []Tier `json:"tiers"`
+	Links   []Link `json:"links"`
+}
+
+type Standing struct {
+	League   string `json:"league"`
+	Team     string `json:"team"`
+	Position int32  `json:"position"`
+}
+
+// Reasons a team moves between leagues at the end of a season.
+const (
+	ReasonPromoted         = "promoted"
+	ReasonPlayoffPromotion = "playoff_promotion"
+	ReasonRelegated        = "relegated"
+)
+
+type Movement struct {
+	Team       string `json:"team"`
+	FromLeague string `json:"fromLeague"`
+	ToLeague   string `json:"toLeague"`
+	Reason     string `json:"reason"`
 }
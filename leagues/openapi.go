@@ -0,0 +1,43 @@
+package leagues
+
+import (
+	"github.com/rchauhan9/sportech/auth"
+	"github.com/rchauhan9/sportech/openapi"
+	"net/http"
+)
+
+var Routes = []openapi.Route{
+	{
+		Method:   http.MethodGet,
+		Path:     "/leagues/",
+		Tag:      "leagues",
+		Summary:  "List leagues",
+		Query:    []openapi.Parameter{openapi.FormatParam},
+		Response: listLeaguesResponse{},
+		Formats:  true,
+	},
+	{
+		Method:   http.MethodGet,
+		Path:     "/leagues/{id}",
+		Tag:      "leagues",
+		Summary:  "Get a league",
+		Response: getLeagueResponse{},
+	},
+	{
+		Method:   http.MethodGet,
+		Path:     "/countries/{id}/pyramid",
+		Tag:      "leagues",
+		Summary:  "Get a country's league pyramid",
+		Response: getPyramidResponse{},
+	},
+	{
+		Method:      http.MethodPost,
+		Path:        "/countries/{id}/pyramid/end-of-season",
+		Tag:         "leagues",
+		Summary:     "Apply promotion and relegation at the end of a season",
+		Description: "playoffWinners maps each league with playoff places to the team that won its playoff.",
+		Request:     endSeasonRequest{},
+		Response:    endSeasonResponse{},
+		Scope:       auth.ScopeAdmin,
+	},
+}
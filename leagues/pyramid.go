@@ -0,0 +1,106 @@
+package leagues
+
+import (
+	"github.com/pkg/errors"
+	"sort"
+)
+
+// buildTiers groups leagues by tier level, top tier first. Leagues without a tier aren't part
+// of the pyramid and are left out.
+func buildTiers(leagues []League) []Tier {
+	byLevel := map[int32][]League{}
+	for _, league := range leagues {
+		if league.Tier == nil {
+			continue
+		}
+		byLevel[*league.Tier] = append(byLevel[*league.Tier], league)
+	}
+
+	tiers := make([]Tier, 0, len(byLevel))
+	for level, leagues := range byLevel {
+		tiers = append(tiers, Tier{Level: level, Leagues: leagues})
+	}
+	sort.Slice(tiers, func(i, j int) bool {
+		return tiers[i].Level < tiers[j].Level
+	})
+	return tiers
+}
+
+// planMovements works out which teams change league from the final standings of a season.
+// playoffWinners maps a lower league ID to the team that won its promotion playoff, and is
+// required for every link with playoff places. When an upper league has several lower links,
+// its relegated teams are allocated to them bottom-up in the order the links are given.
+func planMovements(links []Link, standings []Standing, playoffWinners map[string]string) ([]Movement, error) {
+	tables := map[string][]Standing{}
+	for _, standing := range standings {
+		tables[standing.League] = append(tables[standing.League], standing)
+	}
+	for _, table := range tables {
+		sort.Slice(table, func(i, j int) bool {
+			return table[i].Position < table[j].Position
+		})
+	}
+
+	var movements []Movement
+	moved := map[string]bool{}
+	relegatedSoFar := map[string]int32{}
+	move := func(team string, from string, to string, reason string) error {
+		if moved[team] {
+			return errors.Wrapf(ErrInvalidArgument, "team %s would move more than once", team)
+		}
+		moved[team] = true
+		movements = append(movements, Movement{Team: team, FromLeague: from, ToLeague: to, Reason: reason})
+		return nil
+	}
+
+	for _, link := range links {
+		upper, ok := tables[link.UpperLeague]
+		if !ok {
+			return nil, errors.Wrapf(ErrInvalidArgument, "no final standings for league %s", link.UpperLeague)
+		}
+		lower, ok := tables[link.LowerLeague]
+		if !ok {
+			return nil, errors.Wrapf(ErrInvalidArgument, "no final standings for league %s", link.LowerLeague)
+		}
+
+		if int(link.PromotionPlaces+link.PlayoffPlaces) > len(lower) {
+			return nil, errors.Wrapf(ErrInvalidArgument, "league %s has fewer teams than promotion and playoff places", link.LowerLeague)
+		}
+		for _, standing := range lower[:link.PromotionPlaces] {
+			if err := move(standing.Team, link.LowerLeague, link.UpperLeague, ReasonPromoted); err != nil {
+				return nil, err
+			}
+		}
+
+		if link.PlayoffPlaces > 0 {
+			winner, ok := playoffWinners[link.LowerLeague]
+			if !ok {
+				return nil, errors.Wrapf(ErrInvalidArgument, "no playoff winner given for league %s", link.LowerLeague)
+			}
+			eligible := false
+			for _, standing := range lower[link.PromotionPlaces : link.PromotionPlaces+link.PlayoffPlaces] {
+				eligible = eligible || standing.Team == winner
+			}
+			if !eligible {
+				return nil, errors.Wrapf(ErrInvalidArgument, "team %s did not finish in a playoff place in league %s", winner, link.LowerLeague)
+			}
+			if err := move(winner, link.LowerLeague, link.UpperLeague, ReasonPlayoffPromotion); err != nil {
+				return nil, err
+			}
+		}
+
+		end := int32(len(upper)) - relegatedSoFar[link.UpperLeague]
+		start := end - link.RelegationPlaces
+		if start < 0 {
+			return nil, errors.Wrapf(ErrInvalidArgument, "league %s has fewer teams than relegation places", link.UpperLeague)
+		}
+		for _, standing := range upper[start:end] {
+			if err := move(standing.Team, link.UpperLeague, link.LowerLeague, ReasonRelegated); err != nil {
+				return nil, err
+			}
+		}
+		relegatedSoFar[link.UpperLeague] += link.RelegationPlaces
+	}
+
+	return movements, nil
+}
@@ -0,0 +1,102 @@
+package leagues
+
+import (
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func table(league string, teams ...string) []Standing {
+	standings := make([]Standing, len(teams))
+	for i, team := range teams {
+		standings[i] = Standing{League: league, Team: team, Position: int32(i + 1)}
+	}
+	return standings
+}
+
+func TestPlanMovements(t *testing.T) {
+	links := []Link{
+		{UpperLeague: "premier", LowerLeague: "championship", PromotionPlaces: 2, RelegationPlaces: 3, PlayoffPlaces: 4},
+		{UpperLeague: "championship", LowerLeague: "league-one", PromotionPlaces: 1, RelegationPlaces: 1},
+	}
+	var standings []Standing
+	standings = append(standings, table("premier", "p1", "p2", "p3", "p4", "p5", "p6")...)
+	standings = append(standings, table("championship", "c1", "c2", "c3", "c4", "c5", "c6", "c7")...)
+	standings = append(standings, table("league-one", "l1", "l2", "l3")...)
+
+	movements, err := planMovements(links, standings, map[string]string{"championship": "c5"})
+	require.NoError(t, err)
+
+	require.Equal(t, []Movement{
+		{Team: "c1", FromLeague: "championship", ToLeague: "premier", Reason: ReasonPromoted},
+		{Team: "c2", FromLeague: "championship", ToLeague: "premier", Reason: ReasonPromoted},
+		{Team: "c5", FromLeague: "championship", ToLeague: "premier", Reason: ReasonPlayoffPromotion},
+		{Team: "p4", FromLeague: "premier", ToLeague: "championship", Reason: ReasonRelegated},
+		{Team: "p5", FromLeague: "premier", ToLeague: "championship", Reason: ReasonRelegated},
+		{Team: "p6", FromLeague: "premier", ToLeague: "championship", Reason: ReasonRelegated},
+		{Team: "l1", FromLeague: "league-one", ToLeague: "championship", Reason: ReasonPromoted},
+		{Team: "c7", FromLeague: "championship", ToLeague: "league-one", Reason: ReasonRelegated},
+	}, movements)
+}
+
+func TestPlanMovementsSplitsRelegationAcrossLowerLeagues(t *testing.T) {
+	links := []Link{
+		{UpperLeague: "national", LowerLeague: "north", PromotionPlaces: 1, RelegationPlaces: 1},
+		{UpperLeague: "national", LowerLeague: "south", PromotionPlaces: 1, RelegationPlaces: 1},
+	}
+	var standings []Standing
+	standings = append(standings, table("national", "n1", "n2", "n3", "n4")...)
+	standings = append(standings, table("north", "a1", "a2")...)
+	standings = append(standings, table("south", "b1", "b2")...)
+
+	movements, err := planMovements(links, standings, nil)
+	require.NoError(t, err)
+
+	require.Equal(t, []Movement{
+		{Team: "a1", FromLeague: "north", ToLeague: "national", Reason: ReasonPromoted},
+		{Team: "n4", FromLeague: "national", ToLeague: "north", Reason: ReasonRelegated},
+		{Team: "b1", FromLeague: "south", ToLeague: "national", Reason: ReasonPromoted},
+		{Team: "n3", FromLeague: "national", ToLeague: "south", Reason: ReasonRelegated},
+	}, movements)
+}
+
+func TestPlanMovementsRejectsInvalidPlayoffWinner(t *testing.T) {
+	links := []Link{
+		{UpperLeague: "premier", LowerLeague: "championship", PromotionPlaces: 1, RelegationPlaces: 2, PlayoffPlaces: 2},
+	}
+	var standings []Standing
+	standings = append(standings, table("premier", "p1", "p2", "p3")...)
+	standings = append(standings, table("championship", "c1", "c2", "c3", "c4")...)
+
+	_, err := planMovements(links, standings, nil)
+	require.Equal(t, ErrInvalidArgument, errors.Cause(err))
+
+	_, err = planMovements(links, standings, map[string]string{"championship": "c4"})
+	require.Equal(t, ErrInvalidArgument, errors.Cause(err))
+}
+
+func TestPlanMovementsRequiresStandings(t *testing.T) {
+	links := []Link{
+		{UpperLeague: "premier", LowerLeague: "championship", PromotionPlaces: 1, RelegationPlaces: 1},
+	}
+
+	_, err := planMovements(links, table("premier", "p1", "p2"), nil)
+	require.Equal(t, ErrInvalidArgument, errors.Cause(err))
+}
+
+func TestBuildTiers(t *testing.T) {
+	one, two := int32(1), int32(2)
+	leagues := []League{
+		{ID: "north", Tier: &two},
+		{ID: "premier", Tier: &one},
+		{ID: "south", Tier: &two},
+		{ID: "unranked"},
+	}
+
+	tiers := buildTiers(leagues)
+
+	require.Equal(t, []Tier{
+		{Level: 1, Leagues: []League{leagues[1]}},
+		{Level: 2, Leagues: []League{leagues[0], leagues[2]}},
+	}, tiers)
+}
@@ -2,13 +2,24 @@ package leagues
 
 import (
 	"context"
+	"github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/pgxpool"
 	"github.com/pkg/errors"
+	"github.com/rchauhan9/sportech/database"
 )
 
+// Tables lists the tables leagues and their pyramids are read from.
+var Tables = []string{"leagues", "league_links", "league_memberships", "league_standings", "teams"}
+
 type Repository interface {
 	ListLeagues(ctx context.Context) ([]League, error)
+	StreamLeagues(ctx context.Context, yield func(League) error) error
 	GetLeague(ctx context.Context, id string) (League, error)
+	GetLeagues(ctx context.Context, ids []string) (map[string]League, error)
+	ListCountryLeagues(ctx context.Context, countryID string) ([]League, error)
+	ListCountryLinks(ctx context.Context, countryID string) ([]Link, error)
+	ListCountryStandings(ctx context.Context, countryID string, season int32) ([]Standing, error)
+	ApplySeasonMovements(ctx context.Context, season int32, standings []Standing, movements []Movement) error
 }
 
 func NewRepository(dbPool *pgxpool.Pool) Repository {
@@ -19,22 +30,23 @@ type repository struct {
 	pool *pgxpool.Pool
 }
 
-func (r *repository) ListLeagues(ctx context.Context) ([]League, error) {
+func (r *repository) StreamLeagues(ctx context.Context, yield func(League) error) error {
 	query := `
 		SELECT
 		    id,
 		    name,
 		    number_of_teams,
-		    country_id
+		    country_id,
+		    tier
 	    FROM leagues
 		ORDER BY name
 	`
 	rows, err := r.pool.Query(ctx, query)
 	if err != nil {
-		return nil, errors.Wrap(err, "errpr fetching discover category details from database")
+		return errors.Wrap(err, "errpr fetching discover category details from database")
 	}
 
-	var leagues []League
+	defer rows.Close()
 	for rows.Next() {
 		league := League{}
 		if err := rows.Scan(
@@ -42,12 +54,24 @@ func (r *repository) ListLeagues(ctx context.Context) ([]League, error) {
 			&league.Name,
 			&league.NumberOfTeams,
 			&league.Country,
+			&league.Tier,
 		); err != nil {
-			return nil, errors.Wrap(err, "error scanning row from database")
+			return errors.Wrap(err, "error scanning row from database")
+		}
+		if err := yield(league); err != nil {
+			return err
 		}
-		leagues = append(leagues, league)
 	}
-	return leagues, nil
+	return rows.Err()
+}
+
+func (r *repository) ListLeagues(ctx context.Context) ([]League, error) {
+	var leagues []League
+	err := r.StreamLeagues(ctx, func(league League) error {
+		leagues = append(leagues, league)
+		return nil
+	})
+	return leagues, err
 }
 
 func (r *repository) GetLeague(ctx context.Context, id string) (League, error) {
@@ -56,7 +80,8 @@ func (r *repository) GetLeague(ctx context.Context, id string) (League, error) {
 		    id,
 		    name,
 		    number_of_teams,
-		    country_id
+		    country_id,
+		    tier
 	    FROM leagues
 	    WHERE id = $1
 	`
@@ -67,8 +92,191 @@ func (r *repository) GetLeague(ctx context.Context, id string) (League, error) {
 		&league.Name,
 		&league.NumberOfTeams,
 		&league.Country,
+		&league.Tier,
 	); err != nil {
 		return league, errors.Wrapf(err, "error getting league with id %s", id)
 	}
 	return league, nil
 }
+
+// GetLeagues looks up many leagues in one query, keyed by ID. IDs that match no league are left
+// out of the result.
+func (r *repository) GetLeagues(ctx context.Context, ids []string) (map[string]League, error) {
+	query := `
+	    SELECT
+		    id,
+		    name,
+		    number_of_teams,
+		    country_id,
+		    tier
+	    FROM leagues
+	    WHERE id = ANY($1)
+	`
+	rows, err := r.pool.Query(ctx, query, ids)
+	if err != nil {
+		return nil, errors.Wrap(err, "error fetching leagues from database")
+	}
+	defer rows.Close()
+
+	leagues := make(map[string]League, len(ids))
+	for rows.Next() {
+		league := League{}
+		if err := rows.Scan(
+			&league.ID,
+			&league.Name,
+			&league.NumberOfTeams,
+			&league.Country,
+			&league.Tier,
+		); err != nil {
+			return nil, errors.Wrap(err, "error scanning row from database")
+		}
+		leagues[league.ID] = league
+	}
+	return leagues, rows.Err()
+}
+
+func (r *repository) ListCountryLeagues(ctx context.Context, countryID string) ([]League, error) {
+	query := `
+		SELECT
+		    id,
+		    name,
+		    number_of_teams,
+		    country_id,
+		    tier
+	    FROM leagues
+	    WHERE country_id = $1
+		ORDER BY tier NULLS LAST, name
+	`
+	rows, err := r.pool.Query(ctx, query, countryID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error fetching leagues for country with id %s", countryID)
+	}
+	defer rows.Close()
+
+	var leagues []League
+	for rows.Next() {
+		league := League{}
+		if err := rows.Scan(
+			&league.ID,
+			&league.Name,
+			&league.NumberOfTeams,
+			&league.Country,
+			&league.Tier,
+		); err != nil {
+			return nil, errors.Wrap(err, "error scanning row from database")
+		}
+		leagues = append(leagues, league)
+	}
+	return leagues, rows.Err()
+}
+
+func (r *repository) ListCountryLinks(ctx context.Context, countryID string) ([]Link, error) {
+	query := `
+		SELECT
+		    k.id,
+		    k.upper_league_id,
+		    k.lower_league_id,
+		    k.promotion_places,
+		    k.relegation_places,
+		    k.playoff_places
+	    FROM league_links k
+	    JOIN leagues u ON u.id = k.upper_league_id
+	    JOIN leagues l ON l.id = k.lower_league_id
+	    WHERE u.country_id = $1
+		ORDER BY u.tier NULLS LAST, u.name, l.name
+	`
+	rows, err := r.pool.Query(ctx, query, countryID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error fetching league links for country with id %s", countryID)
+	}
+	defer rows.Close()
+
+	var links []Link
+	for rows.Next() {
+		link := Link{}
+		if err := rows.Scan(
+			&link.ID,
+			&link.UpperLeague,
+			&link.LowerLeague,
+			&link.PromotionPlaces,
+			&link.RelegationPlaces,
+			&link.PlayoffPlaces,
+		); err != nil {
+			return nil, errors.Wrap(err, "error scanning row from database")
+		}
+		links = append(links, link)
+	}
+	return links, rows.Err()
+}
+
+func (r *repository) ListCountryStandings(ctx context.Context, countryID string, season int32) ([]Standing, error) {
+	query := `
+		SELECT
+		    s.league_id,
+		    s.team_id,
+		    s.position
+	    FROM league_standings s
+	    JOIN leagues l ON l.id = s.league_id
+	    WHERE l.country_id = $1 AND s.season = $2
+		ORDER BY s.league_id, s.position
+	`
+	rows, err := r.pool.Query(ctx, query, countryID, season)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error fetching %d standings for country with id %s", season, countryID)
+	}
+	defer rows.Close()
+
+	var standings []Standing
+	for rows.Next() {
+		standing := Standing{}
+		if err := rows.Scan(
+			&standing.League,
+			&standing.Team,
+			&standing.Position,
+		); err != nil {
+			return nil, errors.Wrap(err, "error scanning row from database")
+		}
+		standings = append(standings, standing)
+	}
+	return standings, rows.Err()
+}
+
+// ApplySeasonMovements records next season's league for every team in standings and points
+// teams.league_id at it, in a single transaction.
+func (r *repository) ApplySeasonMovements(ctx context.Context, season int32, standings []Standing, movements []Movement) error {
+	nextLeague := map[string]string{}
+	for _, standing := range standings {
+		nextLeague[standing.Team] = standing.League
+	}
+	for _, movement := range movements {
+		nextLeague[movement.Team] = movement.ToLeague
+	}
+
+	return r.pool.BeginFunc(ctx, func(tx pgx.Tx) error {
+		if err := database.LockTableVersions(ctx, tx, "league_memberships", "teams"); err != nil {
+			return err
+		}
+		for team, league := range nextLeague {
+			query := `
+			    INSERT INTO league_memberships (league_id, team_id, season)
+			    VALUES
+			    ($1, $2, $3)
+			    ON CONFLICT (team_id, season) DO UPDATE SET league_id = EXCLUDED.league_id
+			`
+			if _, err := tx.Exec(ctx, query, league, team, season+1); err != nil {
+				return errors.Wrapf(err, "error recording %d league for team with id %s", season+1, team)
+			}
+		}
+		for _, movement := range movements {
+			query := `
+			    UPDATE teams
+			    SET league_id = $1
+			    WHERE id = $2
+			`
+			if _, err := tx.Exec(ctx, query, movement.ToLeague, movement.Team); err != nil {
+				return errors.Wrapf(err, "error moving team with id %s", movement.Team)
+			}
+		}
+		return nil
+	})
+}
@@ -63,11 +63,11 @@ func (suite *RepositoryTestSuite) TearDownSuite() {
 }
 
 func (suite *RepositoryTestSuite) SetupTest() {
-	suite.cleaner.Acquire("countries", "leagues")
+	suite.cleaner.Acquire("countries", "leagues", "league_links", "league_standings", "league_memberships", "teams")
 }
 
 func (suite *RepositoryTestSuite) TearDownTest() {
-	suite.cleaner.Clean("countries", "leagues")
+	suite.cleaner.Clean("countries", "leagues", "league_links", "league_standings", "league_memberships", "teams")
 }
 
 func TestRepositoryTestSuite(t *testing.T) {
@@ -109,6 +109,64 @@ func (suite *RepositoryTestSuite) TestGetLeague() {
 	require.Equal(suite.T(), england, league.Country)
 }
 
+func (suite *RepositoryTestSuite) TestListCountryLeaguesAndLinks() {
+	england := uuid.New().String()
+	spain := uuid.New().String()
+	premierLeague := createTieredLeague(suite, "Premier League", 20, england, 1)
+	championship := createTieredLeague(suite, "Championship", 24, england, 2)
+	_ = createTieredLeague(suite, "La Liga", 20, spain, 1)
+	link := createLeagueLink(suite, premierLeague, championship, 2, 3, 4)
+
+	leagues, err := suite.repository.ListCountryLeagues(suite.ctx, england)
+	require.NoError(suite.T(), err)
+
+	require.Equal(suite.T(), 2, len(leagues))
+	require.Equal(suite.T(), premierLeague, leagues[0].ID)
+	require.Equal(suite.T(), int32(1), *leagues[0].Tier)
+	require.Equal(suite.T(), championship, leagues[1].ID)
+	require.Equal(suite.T(), int32(2), *leagues[1].Tier)
+
+	links, err := suite.repository.ListCountryLinks(suite.ctx, england)
+	require.NoError(suite.T(), err)
+
+	require.Equal(suite.T(), 1, len(links))
+	require.Equal(suite.T(), link, links[0].ID)
+	require.Equal(suite.T(), premierLeague, links[0].UpperLeague)
+	require.Equal(suite.T(), championship, links[0].LowerLeague)
+	require.Equal(suite.T(), int32(2), links[0].PromotionPlaces)
+	require.Equal(suite.T(), int32(3), links[0].RelegationPlaces)
+	require.Equal(suite.T(), int32(4), links[0].PlayoffPlaces)
+}
+
+func (suite *RepositoryTestSuite) TestApplySeasonMovements() {
+	england := uuid.New().String()
+	premierLeague := createTieredLeague(suite, "Premier League", 2, england, 1)
+	championship := createTieredLeague(suite, "Championship", 2, england, 2)
+	stays := createTeamInLeague(suite, "Liverpool Football Club", premierLeague)
+	relegated := createTeamInLeague(suite, "Burnley Football Club", premierLeague)
+	promoted := createTeamInLeague(suite, "Fulham Football Club", championship)
+	createStanding(suite, premierLeague, 2021, stays, 1)
+	createStanding(suite, premierLeague, 2021, relegated, 2)
+	createStanding(suite, championship, 2021, promoted, 1)
+
+	standings, err := suite.repository.ListCountryStandings(suite.ctx, england, 2021)
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), 3, len(standings))
+
+	err = suite.repository.ApplySeasonMovements(suite.ctx, 2021, standings, []leagues.Movement{
+		{Team: promoted, FromLeague: championship, ToLeague: premierLeague, Reason: leagues.ReasonPromoted},
+		{Team: relegated, FromLeague: premierLeague, ToLeague: championship, Reason: leagues.ReasonRelegated},
+	})
+	require.NoError(suite.T(), err)
+
+	require.Equal(suite.T(), premierLeague, teamLeague(suite, stays))
+	require.Equal(suite.T(), championship, teamLeague(suite, relegated))
+	require.Equal(suite.T(), premierLeague, teamLeague(suite, promoted))
+	require.Equal(suite.T(), premierLeague, membership(suite, stays, 2022))
+	require.Equal(suite.T(), championship, membership(suite, relegated, 2022))
+	require.Equal(suite.T(), premierLeague, membership(suite, promoted, 2022))
+}
+
 func createLeague(suite *RepositoryTestSuite, name string, numberOfTeams int32, countryID string) string {
 	query := `
 	    INSERT INTO leagues (name, number_of_teams, country_id)
@@ -122,3 +180,69 @@ func createLeague(suite *RepositoryTestSuite, name string, numberOfTeams int32,
 	require.NoError(suite.T(), err)
 	return leagueID
 }
+
+func createTieredLeague(suite *RepositoryTestSuite, name string, numberOfTeams int32, countryID string, tier int32) string {
+	query := `
+	    INSERT INTO leagues (name, number_of_teams, country_id, tier)
+	    VALUES
+	    ($1, $2, $3, $4)
+	    RETURNING id
+	`
+	row := suite.dbPool.QueryRow(suite.ctx, query, name, numberOfTeams, countryID, tier)
+	var leagueID string
+	err := row.Scan(&leagueID)
+	require.NoError(suite.T(), err)
+	return leagueID
+}
+
+func createLeagueLink(suite *RepositoryTestSuite, upperLeagueID string, lowerLeagueID string, promotionPlaces int32, relegationPlaces int32, playoffPlaces int32) string {
+	query := `
+	    INSERT INTO league_links (upper_league_id, lower_league_id, promotion_places, relegation_places, playoff_places)
+	    VALUES
+	    ($1, $2, $3, $4, $5)
+	    RETURNING id
+	`
+	row := suite.dbPool.QueryRow(suite.ctx, query, upperLeagueID, lowerLeagueID, promotionPlaces, relegationPlaces, playoffPlaces)
+	var linkID string
+	err := row.Scan(&linkID)
+	require.NoError(suite.T(), err)
+	return linkID
+}
+
+func createTeamInLeague(suite *RepositoryTestSuite, fullName string, leagueID string) string {
+	query := `
+	    INSERT INTO teams (full_name, medium_name, acronym, year_founded, country_id, stadium_id, league_id)
+	    VALUES
+	    ($1, $1, 'FC', 1900, $2, $3, $4)
+	    RETURNING id
+	`
+	row := suite.dbPool.QueryRow(suite.ctx, query, fullName, uuid.NewString(), uuid.NewString(), leagueID)
+	var teamID string
+	err := row.Scan(&teamID)
+	require.NoError(suite.T(), err)
+	return teamID
+}
+
+func createStanding(suite *RepositoryTestSuite, leagueID string, season int32, teamID string, position int32) {
+	query := `
+	    INSERT INTO league_standings (league_id, season, team_id, position)
+	    VALUES
+	    ($1, $2, $3, $4)
+	`
+	_, err := suite.dbPool.Exec(suite.ctx, query, leagueID, season, teamID, position)
+	require.NoError(suite.T(), err)
+}
+
+func teamLeague(suite *RepositoryTestSuite, teamID string) string {
+	var leagueID string
+	err := suite.dbPool.QueryRow(suite.ctx, `SELECT league_id FROM teams WHERE id = $1`, teamID).Scan(&leagueID)
+	require.NoError(suite.T(), err)
+	return leagueID
+}
+
+func membership(suite *RepositoryTestSuite, teamID string, season int32) string {
+	var leagueID string
+	err := suite.dbPool.QueryRow(suite.ctx, `SELECT league_id FROM league_memberships WHERE team_id = $1 AND season = $2`, teamID, season).Scan(&leagueID)
+	require.NoError(suite.T(), err)
+	return leagueID
+}
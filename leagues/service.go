@@ -2,11 +2,18 @@ package leagues
 
 import (
 	"context"
+	"github.com/pkg/errors"
 )
 
+var ErrInvalidArgument = errors.New("invalid argument")
+
 type Service interface {
 	ListLeagues(ctx context.Context) ([]League, error)
+	StreamLeagues(ctx context.Context, yield func(League) error) error
 	GetLeague(ctx context.Context, id string) (League, error)
+	GetLeagues(ctx context.Context, ids []string) (map[string]League, error)
+	GetPyramid(ctx context.Context, countryID string) (Pyramid, error)
+	EndSeason(ctx context.Context, countryID string, season int32, playoffWinners map[string]string) ([]Movement, error)
 }
 
 func NewService(repository Repository) Service {
@@ -21,6 +28,56 @@ func (s *service) ListLeagues(ctx context.Context) ([]League, error) {
 	return s.repository.ListLeagues(ctx)
 }
 
+func (s *service) StreamLeagues(ctx context.Context, yield func(League) error) error {
+	return s.repository.StreamLeagues(ctx, yield)
+}
+
 func (s *service) GetLeague(ctx context.Context, id string) (League, error) {
 	return s.repository.GetLeague(ctx, id)
 }
+
+func (s *service) GetLeagues(ctx context.Context, ids []string) (map[string]League, error) {
+	return s.repository.GetLeagues(ctx, ids)
+}
+
+func (s *service) GetPyramid(ctx context.Context, countryID string) (Pyramid, error) {
+	leagues, err := s.repository.ListCountryLeagues(ctx, countryID)
+	if err != nil {
+		return Pyramid{}, err
+	}
+
+	links, err := s.repository.ListCountryLinks(ctx, countryID)
+	if err != nil {
+		return Pyramid{}, err
+	}
+
+	return Pyramid{Country: countryID, Tiers: buildTiers(leagues), Links: links}, nil
+}
+
+// EndSeason moves teams between the country's leagues according to the final standings of
+// season, and returns the moves it made.
+func (s *service) EndSeason(ctx context.Context, countryID string, season int32, playoffWinners map[string]string) ([]Movement, error) {
+	links, err := s.repository.ListCountryLinks(ctx, countryID)
+	if err != nil {
+		return nil, err
+	}
+
+	standings, err := s.repository.ListCountryStandings(ctx, countryID, season)
+	if err != nil {
+		return nil, err
+	}
+	if len(standings) == 0 {
+		return nil, errors.Wrapf(ErrInvalidArgument, "no final standings for %d in country with id %s", season, countryID)
+	}
+
+	movements, err := planMovements(links, standings, playoffWinners)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repository.ApplySeasonMovements(ctx, season, standings, movements); err != nil {
+		return nil, errors.Wrapf(err, "error ending %d season for country with id %s", season, countryID)
+	}
+
+	return movements, nil
+}
@@ -3,34 +3,54 @@ package main
 import (
 	"context"
 	"flag"
-	"fmt"
 	"github.com/go-kit/log"
 	"github.com/go-kit/log/level"
 	_ "github.com/golang-migrate/migrate/v4/database/postgres"
 	_ "github.com/golang-migrate/migrate/v4/source/file"
+	"github.com/jackc/pgx/v4/pgxpool"
 	"github.com/pkg/errors"
+	"github.com/rchauhan9/sportech/apikeys"
+	"github.com/rchauhan9/sportech/auth"
+	"github.com/rchauhan9/sportech/cache"
 	"github.com/rchauhan9/sportech/commons/go/configutil"
+	"github.com/rchauhan9/sportech/commons/go/logging"
 	"github.com/rchauhan9/sportech/config"
 	"github.com/rchauhan9/sportech/database"
+	"github.com/rchauhan9/sportech/graph"
+	"github.com/rchauhan9/sportech/health"
+	"github.com/rchauhan9/sportech/httpcache"
+	"github.com/rchauhan9/sportech/httpsecurity"
 	"github.com/rchauhan9/sportech/leagues"
 	"github.com/rchauhan9/sportech/managers"
+	"github.com/rchauhan9/sportech/metrics"
 	"github.com/rchauhan9/sportech/middleware"
+	"github.com/rchauhan9/sportech/openapi"
+	pb "github.com/rchauhan9/sportech/pb/sportech/v1"
 	"github.com/rchauhan9/sportech/persons"
 	"github.com/rchauhan9/sportech/players"
+	"github.com/rchauhan9/sportech/ratelimit"
+	"github.com/rchauhan9/sportech/referees"
+	"github.com/rchauhan9/sportech/search"
 	"github.com/rchauhan9/sportech/stadiums"
 	"github.com/rchauhan9/sportech/teams"
+	"github.com/rchauhan9/sportech/tracing"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"sync"
 	"syscall"
+	"time"
 )
 
-func health(w http.ResponseWriter, r *http.Request) {
-	fmt.Fprintf(w, "ok\n")
-}
-
-func docs(w http.ResponseWriter, r *http.Request) {
-	fmt.Fprintf(w, "GET /teams\nGET /stadiums\n")
+func index(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/" {
+		http.NotFound(w, r)
+		return
+	}
+	http.Redirect(w, r, "/docs", http.StatusFound)
 }
 
 func realMain() int {
@@ -47,6 +67,12 @@ func realMain() int {
 	if err != nil {
 		return 1
 	}
+	configured, err := logging.NewLogger(conf.Logging.Format, os.Stderr)
+	if err != nil {
+		level.Error(logger).Log("err", err)
+		return 1
+	}
+	logger = configured
 
 	migrator, err := database.NewMigrator(conf.Database.URL, *migrationPath, logger)
 	if err != nil {
@@ -55,108 +81,529 @@ func realMain() int {
 	if err = migrator.MigrateDb(); err != nil {
 		panic(errors.Wrap(err, "unable to migrate database"))
 	}
+	serviceMetrics := metrics.New()
+	if version, dirty, err := migrator.Version(); err != nil {
+		level.Error(logger).Log("err", errors.Wrap(err, "error reading migration version"))
+	} else {
+		serviceMetrics.SetMigrationVersion(version, dirty)
+	}
+	latestVersion, err := migrator.LatestVersion()
+	if err != nil {
+		panic(errors.Wrap(err, "unable to read migrations"))
+	}
 	if err = migrator.Close(); err != nil {
 		level.Error(logger).Log("err", errors.Wrap(err, "error closing migrator"))
 	}
 
-	db, err := database.NewDatabasePool(ctx, conf.Database.URL)
+	var configurePool []func(*pgxpool.Config)
+	if conf.Tracing.Enabled {
+		stopTracing, err := tracing.Start(ctx, tracing.Options{
+			Exporter:    conf.Tracing.Exporter,
+			Endpoint:    conf.Tracing.Endpoint,
+			Insecure:    conf.Tracing.Insecure,
+			File:        conf.Tracing.File,
+			ServiceName: conf.Tracing.ServiceName,
+			SampleRatio: conf.Tracing.SampleRatio,
+		})
+		if err != nil {
+			level.Error(logger).Log("err", err)
+			return 1
+		}
+		defer func() {
+			if err := stopTracing(context.Background()); err != nil {
+				level.Error(logger).Log("err", err)
+			}
+		}()
+		configurePool = append(configurePool, tracing.ConfigurePool)
+	}
+
+	db, err := database.NewDatabasePool(ctx, conf.Database.URL, configurePool...)
 	if err != nil {
 		level.Error(logger).Log("err", err)
 		return 1
 	}
 	defer db.Close()
+	// Background work is stopped, and waited for, before the database is closed, so that the rate
+	// limiter's final flush of quota usage is written.
+	background, stopBackground := context.WithCancel(ctx)
+	var workers sync.WaitGroup
+	runInBackground := func(run func(context.Context)) {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			run(background)
+		}()
+	}
+	defer func() {
+		stopBackground()
+		workers.Wait()
+	}()
+	if err := serviceMetrics.Register(metrics.NewPoolCollector(db)); err != nil {
+		level.Error(logger).Log("err", err)
+		return 1
+	}
+
+	healthRepository := health.NewRepository(db)
+	checker := health.NewChecker(2 * time.Second)
+	checker.Add("database", health.DatabaseCheck(healthRepository))
+	checker.Add("migrations", health.MigrationCheck(healthRepository, latestVersion))
+	checker.Add("pool", health.PoolCheck(healthRepository))
 
 	mux := http.NewServeMux()
-	mux.HandleFunc("/health", health)
-	mux.HandleFunc("/", docs)
+	// /health predates /livez and is kept for existing probes.
+	mux.Handle("/health", health.MakeLiveHandler())
+	mux.Handle("/livez", health.MakeLiveHandler())
+	mux.Handle("/readyz", health.MakeReadyHandler(checker))
+	mux.Handle("/metrics", serviceMetrics.Handler())
+	mux.HandleFunc("/", index)
+
+	if err := conf.CORS.Validate(); err != nil {
+		level.Error(logger).Log("err", err)
+		return 1
+	}
+
+	spec, err := openapi.NewSpec("Sportech API", "1.0.0",
+		teams.Routes,
+		stadiums.Routes,
+		leagues.Routes,
+		persons.Routes,
+		managers.Routes,
+		players.Routes,
+		referees.Routes,
+		search.Routes,
+		graph.Routes,
+		apikeys.Routes,
+	)
+	if err != nil {
+		level.Error(logger).Log("err", err)
+		return 1
+	}
+	docsHandler, err := openapi.MakeHandler(spec)
+	if err != nil {
+		level.Error(logger).Log("err", err)
+		return 1
+	}
+	mux.Handle("/openapi.json", docsHandler)
+	mux.Handle("/docs", docsHandler)
+
+	apiKeyRepository := apikeys.NewRepository(db)
+	apiKeyService := apikeys.NewService(apiKeyRepository)
+	if conf.Auth.BootstrapKey != "" {
+		if err := apiKeyService.EnsureKey(ctx, "bootstrap", conf.Auth.BootstrapKey, []auth.Scope{auth.ScopeAdmin}); err != nil {
+			level.Error(logger).Log("err", errors.Wrap(err, "error storing bootstrap api key"))
+			return 1
+		}
+	}
+	var authenticator auth.Authenticator = apiKeyService
+	if conf.Auth.JWT.Enabled {
+		var keySet *auth.KeySet
+		if conf.Auth.JWT.JWKSFile != "" {
+			keySet, err = auth.NewFileKeySet(conf.Auth.JWT.JWKSFile)
+		} else {
+			keySet, err = auth.NewURLKeySet(ctx, conf.Auth.JWT.JWKSURL, &http.Client{Timeout: 10 * time.Second})
+		}
+		if err != nil {
+			level.Error(logger).Log("err", err)
+			return 1
+		}
+		authenticator, err = auth.NewJWTAuthenticator(authenticator, keySet, auth.JWTOptions{
+			Issuer:     conf.Auth.JWT.Issuer,
+			Audience:   conf.Auth.JWT.Audience,
+			RolesClaim: conf.Auth.JWT.RolesClaim,
+			Roles:      conf.Auth.JWT.Roles,
+			Leeway:     conf.Auth.JWT.Leeway,
+		})
+		if err != nil {
+			level.Error(logger).Log("err", err)
+			return 1
+		}
+		if conf.Auth.JWT.JWKSFile == "" {
+			runInBackground(func(ctx context.Context) {
+				keySet.Run(ctx, conf.Auth.JWT.RefreshInterval, logger)
+			})
+		}
+	}
+	authenticator = auth.NewAnonymous(authenticator, conf.Auth.AnonymousScopes)
+
+	cacheListener := cache.NewListener(db, logger)
+	newCacheStore := func(tables []string) *cache.Store {
+		store := cache.NewStore(conf.Cache.TTL, conf.Cache.MaxItems)
+		cacheListener.Subscribe(tables, store.Purge)
+		return store
+	}
+
+	cacheRepository := httpcache.NewRepository(db)
+	// Cached resources are all read with ScopeReadPublic, and are checked before validation so that
+	// a revoked credential cannot learn whether a resource has changed.
+	authorizeRead := func(r *http.Request) error {
+		_, err := auth.Authorize(r.Context(), authenticator, auth.ScopeReadPublic)
+		return err
+	}
+	cached := func(h http.Handler, resource httpcache.Resource) http.Handler {
+		return httpcache.MakeHandler(h, cacheRepository, resource, conf.HTTPCache.CacheControl(resource.Name), authorizeRead, logger)
+	}
+
+	rateLimitRepository := ratelimit.NewRepository(db)
+	var limiter ratelimit.Limiter
+	if conf.RateLimit.Distributed {
+		limiter = ratelimit.NewDistributedLimiter(rateLimitRepository, logger)
+	} else {
+		if conf.RateLimit.MaxClients < 1 {
+			level.Error(logger).Log("err", "rateLimit.maxClients must be at least 1")
+			return 1
+		}
+		limiter = ratelimit.NewLocalLimiter(rateLimitRepository, conf.RateLimit.MaxClients, logger)
+	}
+	for _, group := range []string{"teams", "stadiums", "leagues", "persons", "managers", "players", "referees", "search", "graphql", "admin"} {
+		if err := conf.RateLimit.Limit(group).Validate(); err != nil {
+			level.Error(logger).Log("group", group, "err", err)
+			return 1
+		}
+	}
+	limited := func(h http.Handler, group string) http.Handler {
+		if !conf.RateLimit.Enabled {
+			return h
+		}
+		return ratelimit.MakeHandler(h, limiter, group, conf.RateLimit.Limit(group), authenticator, conf.RateLimit.TrustForwardedFor, logger)
+	}
+
+	interceptors := []grpc.UnaryServerInterceptor{tracing.UnaryServerInterceptor, logging.UnaryServerInterceptor, auth.UnaryServerInterceptor}
+	if conf.RateLimit.Enabled {
+		// gRPC calls count against the same groups as the HTTP routes for each resource.
+		groups := map[string]string{
+			"sportech.v1.TeamService":    "teams",
+			"sportech.v1.StadiumService": "stadiums",
+			"sportech.v1.LeagueService":  "leagues",
+			"sportech.v1.PersonService":  "persons",
+			"sportech.v1.ManagerService": "managers",
+			"sportech.v1.PlayerService":  "players",
+			"sportech.v1.RefereeService": "referees",
+			"sportech.v1.SearchService":  "search",
+		}
+		interceptors = append(interceptors, ratelimit.UnaryServerInterceptor(limiter, groups, conf.RateLimit.Limit, authenticator, conf.RateLimit.TrustForwardedFor, logger))
+	}
+	baseGRPCServer := grpc.NewServer(grpc.ChainUnaryInterceptor(interceptors...))
+	reflection.Register(baseGRPCServer)
 
 	teamRepository := teams.NewRepository(db)
 	teamService := teams.NewService(teamRepository)
+	if conf.Cache.Enabled {
+		teamService = teams.NewCachingService(teamService, newCacheStore(teams.Tables))
+	}
 	listTeamsEndpoint := teams.MakeListTeamsEndpoint(teamService)
+	listTeamsEndpoint = middleware.AddAuthorization(listTeamsEndpoint, authenticator, auth.ScopeReadPublic, logger)
 	listTeamsEndpoint = middleware.AddLogging(listTeamsEndpoint, logger)
+	listTeamsEndpoint = middleware.AddMetrics(listTeamsEndpoint, serviceMetrics)
+	listTeamsEndpoint = middleware.AddTracing(listTeamsEndpoint)
 	getTeamEndpoint := teams.MakeGetTeamEndpoint(teamService)
+	getTeamEndpoint = middleware.AddAuthorization(getTeamEndpoint, authenticator, auth.ScopeReadPublic, logger)
 	getTeamEndpoint = middleware.AddLogging(getTeamEndpoint, logger)
-	teamHandler := teams.MakeHandler(listTeamsEndpoint, getTeamEndpoint)
+	getTeamEndpoint = middleware.AddMetrics(getTeamEndpoint, serviceMetrics)
+	getTeamEndpoint = middleware.AddTracing(getTeamEndpoint)
+	listTeamStadiumsEndpoint := teams.MakeListTeamStadiumsEndpoint(teamService)
+	listTeamStadiumsEndpoint = middleware.AddAuthorization(listTeamStadiumsEndpoint, authenticator, auth.ScopeReadPublic, logger)
+	listTeamStadiumsEndpoint = middleware.AddLogging(listTeamStadiumsEndpoint, logger)
+	listTeamStadiumsEndpoint = middleware.AddMetrics(listTeamStadiumsEndpoint, serviceMetrics)
+	listTeamStadiumsEndpoint = middleware.AddTracing(listTeamStadiumsEndpoint)
+	teamHandler := teams.MakeHandler(listTeamsEndpoint, getTeamEndpoint, listTeamStadiumsEndpoint)
+	teamHandler = cached(teamHandler, httpcache.Resource{Name: "teams", Tables: teams.Tables, Daily: true})
+	teamHandler = limited(teamHandler, "teams")
 	mux.Handle("/teams/", teamHandler)
+	pb.RegisterTeamServiceServer(baseGRPCServer, teams.MakeGRPCServer(listTeamsEndpoint, getTeamEndpoint, listTeamStadiumsEndpoint))
 
 	stadiumRepository := stadiums.NewRepository(db)
 	stadiumService := stadiums.NewService(stadiumRepository)
+	if conf.Cache.Enabled {
+		stadiumService = stadiums.NewCachingService(stadiumService, newCacheStore(stadiums.Tables))
+	}
 	listStadiumsEndpoint := stadiums.MakeListStadiumsEndpoint(stadiumService)
+	listStadiumsEndpoint = middleware.AddAuthorization(listStadiumsEndpoint, authenticator, auth.ScopeReadPublic, logger)
 	listStadiumsEndpoint = middleware.AddLogging(listStadiumsEndpoint, logger)
+	listStadiumsEndpoint = middleware.AddMetrics(listStadiumsEndpoint, serviceMetrics)
+	listStadiumsEndpoint = middleware.AddTracing(listStadiumsEndpoint)
 	getStadiumEndpoint := stadiums.MakeGetStadiumEndpoint(stadiumService)
+	getStadiumEndpoint = middleware.AddAuthorization(getStadiumEndpoint, authenticator, auth.ScopeReadPublic, logger)
 	getStadiumEndpoint = middleware.AddLogging(getStadiumEndpoint, logger)
-	stadiumHandler := stadiums.MakeHandler(listStadiumsEndpoint, getStadiumEndpoint)
+	getStadiumEndpoint = middleware.AddMetrics(getStadiumEndpoint, serviceMetrics)
+	getStadiumEndpoint = middleware.AddTracing(getStadiumEndpoint)
+	listStadiumTenantsEndpoint := stadiums.MakeListStadiumTenantsEndpoint(stadiumService)
+	listStadiumTenantsEndpoint = middleware.AddAuthorization(listStadiumTenantsEndpoint, authenticator, auth.ScopeReadPublic, logger)
+	listStadiumTenantsEndpoint = middleware.AddLogging(listStadiumTenantsEndpoint, logger)
+	listStadiumTenantsEndpoint = middleware.AddMetrics(listStadiumTenantsEndpoint, serviceMetrics)
+	listStadiumTenantsEndpoint = middleware.AddTracing(listStadiumTenantsEndpoint)
+	stadiumHandler := stadiums.MakeHandler(listStadiumsEndpoint, getStadiumEndpoint, listStadiumTenantsEndpoint)
+	stadiumHandler = cached(stadiumHandler, httpcache.Resource{Name: "stadiums", Tables: stadiums.Tables, Daily: true})
+	stadiumHandler = limited(stadiumHandler, "stadiums")
 	mux.Handle("/stadiums/", stadiumHandler)
+	pb.RegisterStadiumServiceServer(baseGRPCServer, stadiums.MakeGRPCServer(listStadiumsEndpoint, getStadiumEndpoint, listStadiumTenantsEndpoint))
 
 	leagueRepository := leagues.NewRepository(db)
 	leagueService := leagues.NewService(leagueRepository)
+	if conf.Cache.Enabled {
+		leagueService = leagues.NewCachingService(leagueService, newCacheStore(leagues.Tables))
+	}
 	listLeaguesEndpoint := leagues.MakeListLeaguesEndpoint(leagueService)
+	listLeaguesEndpoint = middleware.AddAuthorization(listLeaguesEndpoint, authenticator, auth.ScopeReadPublic, logger)
 	listLeaguesEndpoint = middleware.AddLogging(listLeaguesEndpoint, logger)
+	listLeaguesEndpoint = middleware.AddMetrics(listLeaguesEndpoint, serviceMetrics)
+	listLeaguesEndpoint = middleware.AddTracing(listLeaguesEndpoint)
 	getLeagueEndpoint := leagues.MakeGetLeagueEndpoint(leagueService)
+	getLeagueEndpoint = middleware.AddAuthorization(getLeagueEndpoint, authenticator, auth.ScopeReadPublic, logger)
 	getLeagueEndpoint = middleware.AddLogging(getLeagueEndpoint, logger)
-	leagueHandler := leagues.MakeHandler(listLeaguesEndpoint, getLeagueEndpoint)
+	getLeagueEndpoint = middleware.AddMetrics(getLeagueEndpoint, serviceMetrics)
+	getLeagueEndpoint = middleware.AddTracing(getLeagueEndpoint)
+	getPyramidEndpoint := leagues.MakeGetPyramidEndpoint(leagueService)
+	getPyramidEndpoint = middleware.AddAuthorization(getPyramidEndpoint, authenticator, auth.ScopeReadPublic, logger)
+	getPyramidEndpoint = middleware.AddLogging(getPyramidEndpoint, logger)
+	getPyramidEndpoint = middleware.AddMetrics(getPyramidEndpoint, serviceMetrics)
+	getPyramidEndpoint = middleware.AddTracing(getPyramidEndpoint)
+	endSeasonEndpoint := leagues.MakeEndSeasonEndpoint(leagueService)
+	endSeasonEndpoint = middleware.AddAuthorization(endSeasonEndpoint, authenticator, auth.ScopeAdmin, logger)
+	endSeasonEndpoint = middleware.AddLogging(endSeasonEndpoint, logger)
+	endSeasonEndpoint = middleware.AddMetrics(endSeasonEndpoint, serviceMetrics)
+	endSeasonEndpoint = middleware.AddTracing(endSeasonEndpoint)
+	leagueHandler := leagues.MakeHandler(listLeaguesEndpoint, getLeagueEndpoint, getPyramidEndpoint, endSeasonEndpoint)
+	leagueHandler = cached(leagueHandler, httpcache.Resource{Name: "leagues", Tables: leagues.Tables})
+	leagueHandler = limited(leagueHandler, "leagues")
 	mux.Handle("/leagues/", leagueHandler)
+	mux.Handle("/countries/", leagueHandler)
+	pb.RegisterLeagueServiceServer(baseGRPCServer, leagues.MakeGRPCServer(listLeaguesEndpoint, getLeagueEndpoint, getPyramidEndpoint, endSeasonEndpoint))
 
 	personsRepository := persons.NewRepository(db)
 	personsService := persons.NewService(personsRepository)
+	if conf.Cache.Enabled {
+		personsService = persons.NewCachingService(personsService, newCacheStore(persons.Tables))
+	}
+	getPersonEndpoint := persons.MakeGetPersonEndpoint(personsService)
+	getPersonEndpoint = middleware.AddAuthorization(getPersonEndpoint, authenticator, auth.ScopeReadPublic, logger)
+	getPersonEndpoint = middleware.AddLogging(getPersonEndpoint, logger)
+	getPersonEndpoint = middleware.AddMetrics(getPersonEndpoint, serviceMetrics)
+	getPersonEndpoint = middleware.AddTracing(getPersonEndpoint)
+	listDuplicatesEndpoint := persons.MakeListDuplicatesEndpoint(personsService)
+	listDuplicatesEndpoint = middleware.AddAuthorization(listDuplicatesEndpoint, authenticator, auth.ScopeAdmin, logger)
+	listDuplicatesEndpoint = middleware.AddLogging(listDuplicatesEndpoint, logger)
+	listDuplicatesEndpoint = middleware.AddMetrics(listDuplicatesEndpoint, serviceMetrics)
+	listDuplicatesEndpoint = middleware.AddTracing(listDuplicatesEndpoint)
+	mergePersonsEndpoint := persons.MakeMergePersonsEndpoint(personsService)
+	mergePersonsEndpoint = middleware.AddAuthorization(mergePersonsEndpoint, authenticator, auth.ScopeAdmin, logger)
+	mergePersonsEndpoint = middleware.AddLogging(mergePersonsEndpoint, logger)
+	mergePersonsEndpoint = middleware.AddMetrics(mergePersonsEndpoint, serviceMetrics)
+	mergePersonsEndpoint = middleware.AddTracing(mergePersonsEndpoint)
+	personHandler := persons.MakeHandler(getPersonEndpoint, listDuplicatesEndpoint, mergePersonsEndpoint)
+	personHandler = cached(personHandler, httpcache.Resource{Name: "persons", Tables: persons.Tables})
+	personHandler = limited(personHandler, "persons")
+	mux.Handle("/persons/", personHandler)
+	pb.RegisterPersonServiceServer(baseGRPCServer, persons.MakeGRPCServer(getPersonEndpoint, listDuplicatesEndpoint, mergePersonsEndpoint))
 
 	managerRepository := managers.NewRepository(db)
 	managerService := managers.NewService(managerRepository, personsService)
 	listManagersEndpoint := managers.MakeListManagersEndpoint(managerService)
+	listManagersEndpoint = middleware.AddAuthorization(listManagersEndpoint, authenticator, auth.ScopeReadPublic, logger)
 	listManagersEndpoint = middleware.AddLogging(listManagersEndpoint, logger)
+	listManagersEndpoint = middleware.AddMetrics(listManagersEndpoint, serviceMetrics)
+	listManagersEndpoint = middleware.AddTracing(listManagersEndpoint)
 	getManagerEndpoint := managers.MakeGetManagerEndpoint(managerService)
+	getManagerEndpoint = middleware.AddAuthorization(getManagerEndpoint, authenticator, auth.ScopeReadPublic, logger)
 	getManagerEndpoint = middleware.AddLogging(getManagerEndpoint, logger)
+	getManagerEndpoint = middleware.AddMetrics(getManagerEndpoint, serviceMetrics)
+	getManagerEndpoint = middleware.AddTracing(getManagerEndpoint)
 	managerHandler := managers.MakeHandler(listManagersEndpoint, getManagerEndpoint)
+	managerHandler = cached(managerHandler, httpcache.Resource{Name: "managers", Tables: managers.Tables})
+	managerHandler = limited(managerHandler, "managers")
 	mux.Handle("/managers/", managerHandler)
+	pb.RegisterManagerServiceServer(baseGRPCServer, managers.MakeGRPCServer(listManagersEndpoint, getManagerEndpoint))
 
 	playerRepository := players.NewRepository(db)
 	playerService := players.NewService(playerRepository, personsService)
 	listPlayersEndpoint := players.MakeListPlayersEndpoint(playerService)
+	listPlayersEndpoint = middleware.AddAuthorization(listPlayersEndpoint, authenticator, auth.ScopeReadPublic, logger)
 	listPlayersEndpoint = middleware.AddLogging(listPlayersEndpoint, logger)
+	listPlayersEndpoint = middleware.AddMetrics(listPlayersEndpoint, serviceMetrics)
+	listPlayersEndpoint = middleware.AddTracing(listPlayersEndpoint)
 	getPlayerEndpoint := players.MakeGetPlayerEndpoint(playerService)
+	getPlayerEndpoint = middleware.AddAuthorization(getPlayerEndpoint, authenticator, auth.ScopeReadPublic, logger)
 	getPlayerEndpoint = middleware.AddLogging(getPlayerEndpoint, logger)
+	getPlayerEndpoint = middleware.AddMetrics(getPlayerEndpoint, serviceMetrics)
+	getPlayerEndpoint = middleware.AddTracing(getPlayerEndpoint)
 	playerHandler := players.MakeHandler(listPlayersEndpoint, getPlayerEndpoint)
+	playerHandler = cached(playerHandler, httpcache.Resource{Name: "players", Tables: players.Tables})
+	playerHandler = limited(playerHandler, "players")
 	mux.Handle("/players/", playerHandler)
+	pb.RegisterPlayerServiceServer(baseGRPCServer, players.MakeGRPCServer(listPlayersEndpoint, getPlayerEndpoint))
+
+	refereeRepository := referees.NewRepository(db)
+	refereeService := referees.NewService(refereeRepository, personsService)
+	listRefereesEndpoint := referees.MakeListRefereesEndpoint(refereeService)
+	listRefereesEndpoint = middleware.AddAuthorization(listRefereesEndpoint, authenticator, auth.ScopeReadPublic, logger)
+	listRefereesEndpoint = middleware.AddLogging(listRefereesEndpoint, logger)
+	listRefereesEndpoint = middleware.AddMetrics(listRefereesEndpoint, serviceMetrics)
+	listRefereesEndpoint = middleware.AddTracing(listRefereesEndpoint)
+	getRefereeEndpoint := referees.MakeGetRefereeEndpoint(refereeService)
+	getRefereeEndpoint = middleware.AddAuthorization(getRefereeEndpoint, authenticator, auth.ScopeReadPublic, logger)
+	getRefereeEndpoint = middleware.AddLogging(getRefereeEndpoint, logger)
+	getRefereeEndpoint = middleware.AddMetrics(getRefereeEndpoint, serviceMetrics)
+	getRefereeEndpoint = middleware.AddTracing(getRefereeEndpoint)
+	listRefereeMatchesEndpoint := referees.MakeListRefereeMatchesEndpoint(refereeService)
+	listRefereeMatchesEndpoint = middleware.AddAuthorization(listRefereeMatchesEndpoint, authenticator, auth.ScopeReadPublic, logger)
+	listRefereeMatchesEndpoint = middleware.AddLogging(listRefereeMatchesEndpoint, logger)
+	listRefereeMatchesEndpoint = middleware.AddMetrics(listRefereeMatchesEndpoint, serviceMetrics)
+	listRefereeMatchesEndpoint = middleware.AddTracing(listRefereeMatchesEndpoint)
+	getRefereeStatsEndpoint := referees.MakeGetRefereeStatsEndpoint(refereeService)
+	getRefereeStatsEndpoint = middleware.AddAuthorization(getRefereeStatsEndpoint, authenticator, auth.ScopeReadPublic, logger)
+	getRefereeStatsEndpoint = middleware.AddLogging(getRefereeStatsEndpoint, logger)
+	getRefereeStatsEndpoint = middleware.AddMetrics(getRefereeStatsEndpoint, serviceMetrics)
+	getRefereeStatsEndpoint = middleware.AddTracing(getRefereeStatsEndpoint)
+	refereeHandler := referees.MakeHandler(listRefereesEndpoint, getRefereeEndpoint, listRefereeMatchesEndpoint, getRefereeStatsEndpoint)
+	refereeHandler = cached(refereeHandler, httpcache.Resource{Name: "referees", Tables: referees.Tables})
+	refereeHandler = limited(refereeHandler, "referees")
+	mux.Handle("/referees/", refereeHandler)
+	pb.RegisterRefereeServiceServer(baseGRPCServer, referees.MakeGRPCServer(listRefereesEndpoint, getRefereeEndpoint, listRefereeMatchesEndpoint, getRefereeStatsEndpoint))
+
+	searchRepository := search.NewRepository(db)
+	searchService := search.NewService(searchRepository)
+	searchEndpoint := search.MakeSearchEndpoint(searchService)
+	searchEndpoint = middleware.AddAuthorization(searchEndpoint, authenticator, auth.ScopeReadPublic, logger)
+	searchEndpoint = middleware.AddLogging(searchEndpoint, logger)
+	searchEndpoint = middleware.AddMetrics(searchEndpoint, serviceMetrics)
+	searchEndpoint = middleware.AddTracing(searchEndpoint)
+	searchHandler := search.MakeHandler(searchEndpoint)
+	searchHandler = cached(searchHandler, httpcache.Resource{Name: "search", Tables: search.Tables})
+	searchHandler = limited(searchHandler, "search")
+	mux.Handle("/search", searchHandler)
+	pb.RegisterSearchServiceServer(baseGRPCServer, search.MakeGRPCServer(searchEndpoint))
+
+	listKeysEndpoint := apikeys.MakeListKeysEndpoint(apiKeyService)
+	listKeysEndpoint = middleware.AddAuthorization(listKeysEndpoint, authenticator, auth.ScopeAdmin, logger)
+	listKeysEndpoint = middleware.AddLogging(listKeysEndpoint, logger)
+	listKeysEndpoint = middleware.AddMetrics(listKeysEndpoint, serviceMetrics)
+	listKeysEndpoint = middleware.AddTracing(listKeysEndpoint)
+	issueKeyEndpoint := apikeys.MakeIssueKeyEndpoint(apiKeyService)
+	issueKeyEndpoint = middleware.AddAuthorization(issueKeyEndpoint, authenticator, auth.ScopeAdmin, logger)
+	issueKeyEndpoint = middleware.AddLogging(issueKeyEndpoint, logger)
+	issueKeyEndpoint = middleware.AddMetrics(issueKeyEndpoint, serviceMetrics)
+	issueKeyEndpoint = middleware.AddTracing(issueKeyEndpoint)
+	rotateKeyEndpoint := apikeys.MakeRotateKeyEndpoint(apiKeyService)
+	rotateKeyEndpoint = middleware.AddAuthorization(rotateKeyEndpoint, authenticator, auth.ScopeAdmin, logger)
+	rotateKeyEndpoint = middleware.AddLogging(rotateKeyEndpoint, logger)
+	rotateKeyEndpoint = middleware.AddMetrics(rotateKeyEndpoint, serviceMetrics)
+	rotateKeyEndpoint = middleware.AddTracing(rotateKeyEndpoint)
+	revokeKeyEndpoint := apikeys.MakeRevokeKeyEndpoint(apiKeyService)
+	revokeKeyEndpoint = middleware.AddAuthorization(revokeKeyEndpoint, authenticator, auth.ScopeAdmin, logger)
+	revokeKeyEndpoint = middleware.AddLogging(revokeKeyEndpoint, logger)
+	revokeKeyEndpoint = middleware.AddMetrics(revokeKeyEndpoint, serviceMetrics)
+	revokeKeyEndpoint = middleware.AddTracing(revokeKeyEndpoint)
+	apiKeyHandler := apikeys.MakeHandler(listKeysEndpoint, issueKeyEndpoint, rotateKeyEndpoint, revokeKeyEndpoint)
+	apiKeyHandler = limited(apiKeyHandler, "admin")
+	mux.Handle("/admin/", apiKeyHandler)
+
+	if conf.RateLimit.Enabled {
+		runInBackground(limiter.Run)
+	}
+
+	if conf.Cache.Enabled {
+		runInBackground(cacheListener.Run)
+	}
+
+	graphService, err := graph.NewService(teamService, stadiumService, leagueService, playerService, managerService, conf.GraphQL.MaxDepth, conf.GraphQL.MaxCost)
+	if err != nil {
+		level.Error(logger).Log("err", err)
+		return 1
+	}
+	graphQLEndpoint := graph.MakeGraphQLEndpoint(graphService)
+	graphQLEndpoint = middleware.AddAuthorization(graphQLEndpoint, authenticator, auth.ScopeReadPublic, logger)
+	graphQLEndpoint = middleware.AddLogging(graphQLEndpoint, logger)
+	graphQLEndpoint = middleware.AddMetrics(graphQLEndpoint, serviceMetrics)
+	graphQLEndpoint = middleware.AddTracing(graphQLEndpoint)
+	graphHandler := graph.MakeHandler(graphQLEndpoint)
+	graphHandler = limited(graphHandler, "graphql")
+	mux.Handle("/graphql", graphHandler)
 
+	// The access log identifies clients by address; the audit log records who they authenticated as.
+	clientKey := func(r *http.Request) string {
+		return "ip:" + ratelimit.ClientIP(r, conf.RateLimit.TrustForwardedFor)
+	}
+	var handler http.Handler = mux
+	handler = logging.MakeHandler(handler, logger, clientKey)
+	handler = auth.HTTPToContext(handler)
+	handler = serviceMetrics.MakeHandler(handler)
+	handler = tracing.MakeHandler(handler)
+	handler = jsonByDefault(handler)
+	handler = httpsecurity.MakeCORSHandler(handler, conf.CORS, httpsecurity.RoutesFromSpec(spec))
+	handler = httpsecurity.MakeHeadersHandler(handler, conf.SecurityHeaders)
+
+	// Requests are served with a context of their own, cancelled once draining is over so that
+	// streams still running stop querying the database.
+	requests, cancelRequests := context.WithCancel(ctx)
+	defer cancelRequests()
 	baseHTTPServer := http.Server{
-		Addr:    ":" + conf.Port,
-		Handler: accessControl(mux),
+		Addr:        ":" + conf.Port,
+		Handler:     handler,
+		BaseContext: func(net.Listener) context.Context { return requests },
 	}
 
-	defer func() {
-		if err := baseHTTPServer.Shutdown(ctx); err != nil {
-			level.Error(logger).Log("err", errors.Wrap(err, "error shutting down http server"))
+	errs := make(chan error, 2)
+	go func() {
+		level.Info(logger).Log("transport", "http", "address", conf.Port, "msg", "listening")
+		if err := baseHTTPServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			errs <- err
 		}
 	}()
-
-	errs := make(chan error, 1)
 	go func() {
-		level.Info(logger).Log("transport", "http", "address", conf.Port, "msg", "listening")
-		if err := baseHTTPServer.ListenAndServe(); err != nil {
+		listener, err := net.Listen("tcp", ":"+conf.GRPCPort)
+		if err != nil {
+			errs <- errors.Wrap(err, "error listening for grpc")
+			return
+		}
+		level.Info(logger).Log("transport", "grpc", "address", conf.GRPCPort, "msg", "listening")
+		if err := baseGRPCServer.Serve(listener); err != nil {
 			errs <- err
 		}
 	}()
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+	exitCode := 0
+	select {
+	case sig := <-signals:
+		level.Info(logger).Log("msg", "shutting down", "signal", sig)
+		// Keep serving while load balancers notice that readiness is failing.
+		checker.Drain()
+		time.Sleep(conf.Shutdown.ReadinessDelay)
+	case err := <-errs:
+		level.Error(logger).Log("msg", "shutting down", "err", err)
+		checker.Drain()
+		exitCode = 1
+	}
+
+	drainCtx, cancelDrain := context.WithTimeout(ctx, conf.Shutdown.DrainTimeout)
+	defer cancelDrain()
+	grpcStopped := make(chan struct{})
 	go func() {
-		c := make(chan os.Signal, 1)
-		signal.Notify(c, syscall.SIGINT)
-		errs <- errors.New((<-c).String())
+		baseGRPCServer.GracefulStop()
+		close(grpcStopped)
 	}()
-	logger.Log("terminated", <-errs)
+	if err := baseHTTPServer.Shutdown(drainCtx); err != nil {
+		level.Warn(logger).Log("msg", "requests still in flight after drain timeout, closing their connections", "err", err)
+		if err := baseHTTPServer.Close(); err != nil {
+			level.Error(logger).Log("err", errors.Wrap(err, "error closing http server"))
+		}
+	}
+	cancelRequests()
+	select {
+	case <-grpcStopped:
+	case <-drainCtx.Done():
+		baseGRPCServer.Stop()
+		<-grpcStopped
+	}
+	level.Info(logger).Log("msg", "stopped")
 
-	return 0
+	return exitCode
 }
 
-func accessControl(h http.Handler) http.Handler {
+// jsonByDefault sends responses as JSON unless their handler says otherwise.
+func jsonByDefault(h http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		w.Header().Set("Access-Control-Allow-Origin", "*")
-		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, OPTIONS")
-		w.Header().Set("Access-Control-Allow-Headers", "Origin, Content-Type")
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
-
-		if r.Method == "OPTIONS" {
-			return
-		}
-
 		h.ServeHTTP(w, r)
 	})
 }
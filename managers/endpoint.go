@@ -3,12 +3,16 @@ package managers
 import (
 	"context"
 	"github.com/go-kit/kit/endpoint"
+	"github.com/rchauhan9/sportech/stream"
 )
 
-type listManagersRequest struct{}
+type listManagersRequest struct {
+	Format string
+}
 
 type listManagersResponse struct {
-	Managers []Manager `json:"managers"`
+	Managers stream.Stream[Manager] `json:"managers"`
+	format   string
 }
 
 type getManagerRequest struct {
@@ -21,9 +25,8 @@ type getManagerResponse struct {
 
 func MakeListManagersEndpoint(svc Service) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
-		_ = request.(listManagersRequest)
-		managers, err := svc.ListManagers(ctx)
-		return listManagersResponse{Managers: managers}, err
+		req := request.(listManagersRequest)
+		return listManagersResponse{Managers: svc.StreamManagers, format: req.Format}, nil
 	}
 }
 
@@ -0,0 +1,108 @@
+package managers
+
+import (
+	"context"
+	"github.com/go-kit/kit/endpoint"
+	grpctransport "github.com/go-kit/kit/transport/grpc"
+	"github.com/pkg/errors"
+	"github.com/rchauhan9/sportech/auth"
+	"github.com/rchauhan9/sportech/commons/go/grpcutil"
+	pb "github.com/rchauhan9/sportech/pb/sportech/v1"
+	"github.com/rchauhan9/sportech/persons"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type grpcServer struct {
+	pb.UnimplementedManagerServiceServer
+	listManagers grpctransport.Handler
+	getManager   grpctransport.Handler
+}
+
+func MakeGRPCServer(listManagersEndpoint endpoint.Endpoint, getManagerEndpoint endpoint.Endpoint) pb.ManagerServiceServer {
+	return &grpcServer{
+		listManagers: grpctransport.NewServer(
+			listManagersEndpoint,
+			decodeGRPCListManagersRequest,
+			encodeGRPCListManagersResponse,
+		),
+		getManager: grpctransport.NewServer(
+			getManagerEndpoint,
+			decodeGRPCGetManagerRequest,
+			encodeGRPCGetManagerResponse,
+		),
+	}
+}
+
+func (s *grpcServer) ListManagers(ctx context.Context, req *pb.ListManagersRequest) (*pb.ListManagersResponse, error) {
+	_, rep, err := s.listManagers.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeGRPCError(err)
+	}
+	return rep.(*pb.ListManagersResponse), nil
+}
+
+func (s *grpcServer) GetManager(ctx context.Context, req *pb.GetManagerRequest) (*pb.GetManagerResponse, error) {
+	_, rep, err := s.getManager.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeGRPCError(err)
+	}
+	return rep.(*pb.GetManagerResponse), nil
+}
+
+func decodeGRPCListManagersRequest(_ context.Context, _ interface{}) (interface{}, error) {
+	return listManagersRequest{}, nil
+}
+
+func encodeGRPCListManagersResponse(ctx context.Context, response interface{}) (interface{}, error) {
+	resp := response.(listManagersResponse)
+	var managers []*pb.Manager
+	if err := resp.Managers(ctx, func(manager Manager) error {
+		managers = append(managers, encodeGRPCManager(manager))
+		return nil
+	}); err != nil {
+		return nil, encodeGRPCError(err)
+	}
+	return &pb.ListManagersResponse{Managers: managers}, nil
+}
+
+func decodeGRPCGetManagerRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.GetManagerRequest)
+	return getManagerRequest{ID: req.Id}, nil
+}
+
+func encodeGRPCGetManagerResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(getManagerResponse)
+	return &pb.GetManagerResponse{Manager: encodeGRPCManager(resp.Manager)}, nil
+}
+
+func encodeGRPCManager(manager Manager) *pb.Manager {
+	return &pb.Manager{
+		Id:          manager.ID,
+		FirstName:   manager.FirstName,
+		MiddleNames: manager.MiddleNames,
+		LastName:    manager.LastName,
+		KnownAs:     manager.KnownAs,
+		DateOfBirth: timestamppb.New(manager.DateOfBirth),
+		Nationality: manager.Nationality,
+		Aliases:     persons.EncodeGRPCAliases(manager.Aliases),
+		Team:        manager.Team,
+		Started:     timestamppb.New(manager.Started),
+		Ended:       grpcutil.OptionalTimestamp(manager.Ended),
+	}
+}
+
+// encode errors from business-logic as gRPC statuses
+func encodeGRPCError(err error) error {
+	switch errors.Cause(err) {
+	case ErrInvalidArgument:
+		return status.Error(codes.InvalidArgument, err.Error())
+	case auth.ErrUnauthenticated:
+		return status.Error(codes.Unauthenticated, err.Error())
+	case auth.ErrForbidden:
+		return status.Error(codes.PermissionDenied, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
@@ -7,6 +7,10 @@ import (
 	kithttp "github.com/go-kit/kit/transport/http"
 	"github.com/gorilla/mux"
 	"github.com/pkg/errors"
+	"github.com/rchauhan9/sportech/auth"
+	"github.com/rchauhan9/sportech/commons/go/logging"
+	"github.com/rchauhan9/sportech/commons/go/queryutil"
+	"github.com/rchauhan9/sportech/stream"
 	"net/http"
 )
 
@@ -17,12 +21,14 @@ func MakeHandler(listManagersEndpoint endpoint.Endpoint, getManagerEndpoint endp
 		listManagersEndpoint,
 		decodeListManagersRequest,
 		encodeListManagersResponse,
+		kithttp.ServerErrorEncoder(encodeError),
 	)
 
 	getManagerHandler := kithttp.NewServer(
 		getManagerEndpoint,
 		decodeGetManagerRequest,
 		encodeGetManagerResponse,
+		kithttp.ServerErrorEncoder(encodeError),
 	)
 
 	r.Handle("/managers/{id}", getManagerHandler).Methods("GET")
@@ -32,8 +38,11 @@ func MakeHandler(listManagersEndpoint endpoint.Endpoint, getManagerEndpoint endp
 }
 
 func decodeListManagersRequest(_ context.Context, r *http.Request) (interface{}, error) {
-	_ = mux.Vars(r)
-	return listManagersRequest{}, nil
+	format, err := queryutil.Format(r, ErrInvalidArgument)
+	if err != nil {
+		return nil, err
+	}
+	return listManagersRequest{Format: format}, nil
 }
 
 func encodeListManagersResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
@@ -41,7 +50,8 @@ func encodeListManagersResponse(ctx context.Context, w http.ResponseWriter, resp
 		encodeError(ctx, e.error(), w)
 		return nil
 	}
-	return json.NewEncoder(w).Encode(response)
+	resp := response.(listManagersResponse)
+	return stream.Encode(ctx, w, resp.format, "managers", resp.Managers)
 }
 
 func decodeGetManagerRequest(_ context.Context, r *http.Request) (interface{}, error) {
@@ -66,13 +76,16 @@ type errorer interface {
 }
 
 // encode errors from business-logic
-func encodeError(_ context.Context, err error, w http.ResponseWriter) {
+func encodeError(ctx context.Context, err error, w http.ResponseWriter) {
+	logging.RecordError(ctx, err)
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
-	switch err {
-	//case erro:
-	//	w.WriteHeader(http.StatusNotFound)
-	//case ErrInvalidArgument:
-	//	w.WriteHeader(http.StatusBadRequest)
+	switch errors.Cause(err) {
+	case ErrInvalidArgument:
+		w.WriteHeader(http.StatusBadRequest)
+	case auth.ErrUnauthenticated:
+		w.WriteHeader(http.StatusUnauthorized)
+	case auth.ErrForbidden:
+		w.WriteHeader(http.StatusForbidden)
 	default:
 		w.WriteHeader(http.StatusInternalServerError)
 	}
@@ -1,17 +1,22 @@
 package managers
 
-import "time"
+import (
+	"github.com/rchauhan9/sportech/persons"
+	"time"
+)
 
 type Manager struct {
-	ID          string     `json:"id"`
-	FirstName   string     `json:"firstName"`
-	MiddleNames *string    `json:"middleNames"`
-	LastName    string     `json:"lastName"`
-	DateOfBirth time.Time  `json:"dateOfBirth"`
-	Nationality string     `json:"nationality"`
-	Team        string     `json:"team"`
-	Started     time.Time  `json:"started"`
-	Ended       *time.Time `json:"ended"`
+	ID          string          `json:"id"`
+	FirstName   string          `json:"firstName"`
+	MiddleNames *string         `json:"middleNames"`
+	LastName    string          `json:"lastName"`
+	KnownAs     *string         `json:"knownAs"`
+	DateOfBirth time.Time       `json:"dateOfBirth"`
+	Nationality string          `json:"nationality"`
+	Aliases     []persons.Alias `json:"aliases"`
+	Team        string          `json:"team"`
+	Started     time.Time       `json:"started"`
+	Ended       *time.Time      `json:"ended"`
 }
 
 type ManagerDB struct {
@@ -20,4 +25,6 @@ type ManagerDB struct {
 	TeamID   string
 	Started  time.Time
 	Ended    *time.Time
+	// Person is only filled in by the list queries, not by GetManager.
+	Person persons.Person
 }
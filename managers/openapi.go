@@ -0,0 +1,25 @@
+package managers
+
+import (
+	"github.com/rchauhan9/sportech/openapi"
+	"net/http"
+)
+
+var Routes = []openapi.Route{
+	{
+		Method:   http.MethodGet,
+		Path:     "/managers/",
+		Tag:      "managers",
+		Summary:  "List managers",
+		Query:    []openapi.Parameter{openapi.FormatParam},
+		Response: listManagersResponse{},
+		Formats:  true,
+	},
+	{
+		Method:   http.MethodGet,
+		Path:     "/managers/{id}",
+		Tag:      "managers",
+		Summary:  "Get a manager",
+		Response: getManagerResponse{},
+	},
+}
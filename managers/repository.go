@@ -2,12 +2,19 @@ package managers
 
 import (
 	"context"
+	"fmt"
 	"github.com/jackc/pgx/v4/pgxpool"
 	"github.com/pkg/errors"
+	"github.com/rchauhan9/sportech/persons"
 )
 
+// Tables lists the tables managers are read from, the persons tables included.
+var Tables = append([]string{"team_managers"}, persons.Tables...)
+
 type Repository interface {
 	ListManagers(ctx context.Context) ([]ManagerDB, error)
+	StreamManagers(ctx context.Context, yield func(ManagerDB) error) error
+	ListManagersInTeams(ctx context.Context, teamIDs []string) ([]ManagerDB, error)
 	GetManager(ctx context.Context, id string) (ManagerDB, error)
 }
 
@@ -19,37 +26,67 @@ type repository struct {
 	pool *pgxpool.Pool
 }
 
-func (r *repository) ListManagers(ctx context.Context) ([]ManagerDB, error) {
-	query := `
+// StreamManagers reads each manager along with the person behind them, in one query.
+func (r *repository) StreamManagers(ctx context.Context, yield func(ManagerDB) error) error {
+	return r.streamManagers(ctx, "", nil, yield)
+}
+
+// ListManagersInTeams reads the managers of the given teams only, so relations can be resolved
+// without reading the whole table.
+func (r *repository) ListManagersInTeams(ctx context.Context, teamIDs []string) ([]ManagerDB, error) {
+	var managers []ManagerDB
+	err := r.streamManagers(ctx, "WHERE m.team_id = ANY($1)", []interface{}{teamIDs}, func(manager ManagerDB) error {
+		managers = append(managers, manager)
+		return nil
+	})
+	return managers, err
+}
+
+func (r *repository) streamManagers(ctx context.Context, where string, args []interface{}, yield func(ManagerDB) error) error {
+	query := fmt.Sprintf(`
 		SELECT
 		    m.id,
 		    m.person_id,
 		    m.team_id,
 		    m.started,
-		    m.ended
-	    FROM team_managers m
-	    ORDER BY started ASC
-	`
-	rows, err := r.pool.Query(ctx, query)
+		    m.ended,%s
+	    FROM team_managers m%s
+	    %s
+	    ORDER BY m.started ASC
+	`, persons.Columns, persons.Join("m.person_id"), where)
+	rows, err := r.pool.Query(ctx, query, args...)
 	if err != nil {
-		return nil, errors.Wrap(err, "errpr fetching discover category details from database")
+		return errors.Wrap(err, "errpr fetching discover category details from database")
 	}
 
-	var managers []ManagerDB
+	defer rows.Close()
 	for rows.Next() {
 		manager := ManagerDB{}
-		if err := rows.Scan(
+		person := persons.Scanner{}
+		if err := rows.Scan(append([]interface{}{
 			&manager.ID,
 			&manager.PersonID,
 			&manager.TeamID,
 			&manager.Started,
 			&manager.Ended,
-		); err != nil {
-			return nil, errors.Wrap(err, "error scanning row from database")
+		}, person.Targets()...)...); err != nil {
+			return errors.Wrap(err, "error scanning row from database")
+		}
+		manager.Person = person.Person()
+		if err := yield(manager); err != nil {
+			return err
 		}
-		managers = append(managers, manager)
 	}
-	return managers, nil
+	return rows.Err()
+}
+
+func (r *repository) ListManagers(ctx context.Context) ([]ManagerDB, error) {
+	var managers []ManagerDB
+	err := r.StreamManagers(ctx, func(manager ManagerDB) error {
+		managers = append(managers, manager)
+		return nil
+	})
+	return managers, err
 }
 
 func (r *repository) GetManager(ctx context.Context, id string) (ManagerDB, error) {
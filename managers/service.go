@@ -4,11 +4,15 @@ import (
 	"context"
 	"github.com/pkg/errors"
 	"github.com/rchauhan9/sportech/persons"
-	"github.com/samber/lo"
+	"github.com/rchauhan9/sportech/stream"
 )
 
+var ErrInvalidArgument = errors.New("invalid argument")
+
 type Service interface {
 	ListManagers(ctx context.Context) ([]Manager, error)
+	StreamManagers(ctx context.Context, yield func(Manager) error) error
+	ListManagersInTeams(ctx context.Context, teamIDs []string) (map[string][]Manager, error)
 	GetManager(ctx context.Context, id string) (Manager, error)
 }
 
@@ -22,33 +26,28 @@ type service struct {
 }
 
 func (s *service) ListManagers(ctx context.Context) ([]Manager, error) {
-	managersDB, err := s.repository.ListManagers(ctx)
-	if err != nil {
-		return nil, err
-	}
-
-	people, err := s.personsService.ListPersons(ctx)
+	return stream.Stream[Manager](s.StreamManagers).Collect(ctx)
+}
 
-	personsMap := lo.KeyBy[string, persons.Person](people, func(person persons.Person) string {
-		return person.ID
+// StreamManagers relies on the repository joining in each manager's person, so that a list holds
+// a single connection for as long as it streams.
+func (s *service) StreamManagers(ctx context.Context, yield func(Manager) error) error {
+	return s.repository.StreamManagers(ctx, func(manager ManagerDB) error {
+		return yield(newManager(manager, manager.Person))
 	})
+}
 
-	managers := make([]Manager, len(managersDB))
-	for i := range managersDB {
-		managers[i] = Manager{
-			ID:          managersDB[i].ID,
-			FirstName:   personsMap[managersDB[i].PersonID].FirstName,
-			MiddleNames: personsMap[managersDB[i].PersonID].MiddleNames,
-			LastName:    personsMap[managersDB[i].PersonID].LastName,
-			DateOfBirth: personsMap[managersDB[i].PersonID].DateOfBirth,
-			Nationality: personsMap[managersDB[i].PersonID].Nationality,
-			Team:        managersDB[i].TeamID,
-			Started:     managersDB[i].Started,
-			Ended:       managersDB[i].Ended,
-		}
+// ListManagersInTeams groups the managers of the given teams by team ID. Teams without managers are left out.
+func (s *service) ListManagersInTeams(ctx context.Context, teamIDs []string) (map[string][]Manager, error) {
+	managers, err := s.repository.ListManagersInTeams(ctx, teamIDs)
+	if err != nil {
+		return nil, err
 	}
-
-	return managers, nil
+	byTeam := make(map[string][]Manager, len(teamIDs))
+	for _, manager := range managers {
+		byTeam[manager.TeamID] = append(byTeam[manager.TeamID], newManager(manager, manager.Person))
+	}
+	return byTeam, nil
 }
 
 func (s *service) GetManager(ctx context.Context, id string) (Manager, error) {
@@ -62,15 +61,21 @@ func (s *service) GetManager(ctx context.Context, id string) (Manager, error) {
 		return Manager{}, errors.Wrapf(err, "error getting manager with id %s", id)
 	}
 
+	return newManager(manager, person), nil
+}
+
+func newManager(db ManagerDB, person persons.Person) Manager {
 	return Manager{
-		ID:          manager.ID,
+		ID:          db.ID,
 		FirstName:   person.FirstName,
 		MiddleNames: person.MiddleNames,
 		LastName:    person.LastName,
+		KnownAs:     person.KnownAs,
 		DateOfBirth: person.DateOfBirth,
 		Nationality: person.Nationality,
-		Team:        manager.TeamID,
-		Started:     manager.Started,
-		Ended:       manager.Ended,
-	}, nil
+		Aliases:     person.Aliases,
+		Team:        db.TeamID,
+		Started:     db.Started,
+		Ended:       db.Ended,
+	}
 }
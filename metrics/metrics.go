@@ -0,0 +1,191 @@
+package metrics
+
+import (
+	"context"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/rchauhan9/sportech/commons/go/endpointutil"
+	"github.com/rchauhan9/sportech/stream"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const namespace = "sportech"
+
+// Metrics holds what the service exports at /metrics. Endpoints are named after their request
+// type, as in the logs, so listTeamsRequest in package teams is "teams.listTeams".
+type Metrics struct {
+	registry         *prometheus.Registry
+	endpointRequests *prometheus.CounterVec
+	endpointDuration *prometheus.HistogramVec
+	httpRequests     *prometheus.CounterVec
+	httpDuration     *prometheus.HistogramVec
+	migrationVersion prometheus.Gauge
+	migrationDirty   prometheus.Gauge
+}
+
+func New() *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		endpointRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "endpoint_requests_total",
+			Help:      "Requests handled by each endpoint, over any transport, by whether they succeeded.",
+		}, []string{"endpoint", "outcome"}),
+		endpointDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "endpoint_duration_seconds",
+			Help:      "Time spent in each endpoint, by whether it succeeded.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"endpoint", "outcome"}),
+		httpRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "http_requests_total",
+			Help:      "HTTP requests served, by endpoint and status class such as 2xx.",
+		}, []string{"endpoint", "code"}),
+		httpDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "http_request_duration_seconds",
+			Help:      "Time taken to serve HTTP requests, including writing the response, by endpoint and status class.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"endpoint", "code"}),
+		migrationVersion: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "migration_version",
+			Help:      "The database migration version applied at startup.",
+		}),
+		migrationDirty: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "migration_dirty",
+			Help:      "1 if the last migration failed part way through, 0 otherwise.",
+		}),
+	}
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.endpointRequests,
+		m.endpointDuration,
+		m.httpRequests,
+		m.httpDuration,
+		m.migrationVersion,
+		m.migrationDirty,
+	)
+	return m
+}
+
+// Register adds further collectors, such as a PoolCollector.
+func (m *Metrics) Register(collector prometheus.Collector) error {
+	return m.registry.Register(collector)
+}
+
+func (m *Metrics) SetMigrationVersion(version uint, dirty bool) {
+	m.migrationVersion.Set(float64(version))
+	if dirty {
+		m.migrationDirty.Set(1)
+	} else {
+		m.migrationDirty.Set(0)
+	}
+}
+
+// Handler serves the metrics in the Prometheus text format.
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{})
+}
+
+// Middleware counts and times each request to the endpoint. It also tells MakeHandler which
+// endpoint served an HTTP request. Streamed list responses are timed until the stream is drained,
+// and count as errors if reading it fails.
+func (m *Metrics) Middleware() endpoint.Middleware {
+	return func(next endpoint.Endpoint) endpoint.Endpoint {
+		return func(ctx context.Context, request interface{}) (interface{}, error) {
+			name := endpointutil.Name(request)
+			if slot, ok := ctx.Value(endpointKey).(*string); ok {
+				*slot = name
+			}
+			startTime := time.Now()
+
+			response, err := next(ctx, request)
+			if err == nil {
+				if wrapped, ok := stream.Wrap(response, func(ctx context.Context, run func(context.Context) error) error {
+					err := run(ctx)
+					m.observeEndpoint(name, startTime, err)
+					return err
+				}); ok {
+					return wrapped, nil
+				}
+			}
+			m.observeEndpoint(name, startTime, err)
+			return response, err
+		}
+	}
+}
+
+func (m *Metrics) observeEndpoint(name string, startTime time.Time, err error) {
+	outcome := "success"
+	if err != nil {
+		outcome = "error"
+	}
+	m.endpointRequests.WithLabelValues(name, outcome).Inc()
+	m.endpointDuration.WithLabelValues(name, outcome).Observe(time.Since(startTime).Seconds())
+}
+
+type contextKey int
+
+const endpointKey contextKey = iota
+
+// MakeHandler wraps next, usually the whole mux, to count and time HTTP requests by the endpoint
+// that served them and the class of their status code. Requests that never reached an endpoint,
+// such as those for /docs or rejected while decoding, are labelled "none".
+func (m *Metrics) MakeHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		startTime := time.Now()
+		name := ""
+		sw := &statusWriter{ResponseWriter: w}
+		next.ServeHTTP(sw, r.WithContext(context.WithValue(r.Context(), endpointKey, &name)))
+
+		if name == "" {
+			name = "none"
+		}
+		if sw.status == 0 {
+			sw.status = http.StatusOK
+		}
+		code := strconv.Itoa(sw.status/100) + "xx"
+		m.httpRequests.WithLabelValues(name, code).Inc()
+		m.httpDuration.WithLabelValues(name, code).Observe(time.Since(startTime).Seconds())
+	})
+}
+
+type statusWriter struct {
+	http.ResponseWriter
+	status int
+}
+
+func (w *statusWriter) WriteHeader(status int) {
+	if w.status == 0 {
+		w.status = status
+	}
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *statusWriter) Write(b []byte) (int, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *statusWriter) Flush() {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+func (w *statusWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
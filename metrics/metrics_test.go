@@ -0,0 +1,77 @@
+package metrics
+
+import (
+	"context"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/rchauhan9/sportech/stream"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type listTeamsRequest struct{}
+
+func TestEndpointAndHTTPMetrics(t *testing.T) {
+	m := New()
+	var e endpoint.Endpoint = func(_ context.Context, request interface{}) (interface{}, error) {
+		return nil, nil
+	}
+	e = m.Middleware()(e)
+	var failing endpoint.Endpoint = func(_ context.Context, request interface{}) (interface{}, error) {
+		return nil, errors.New("boom")
+	}
+	failing = m.Middleware()(failing)
+
+	handler := m.MakeHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/teams/":
+			e(r.Context(), listTeamsRequest{})
+		case "/fail":
+			failing(r.Context(), &listTeamsRequest{})
+			w.WriteHeader(http.StatusInternalServerError)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	for _, path := range []string{"/teams/", "/teams/", "/fail", "/docs"} {
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+	}
+
+	require.Equal(t, 2.0, testutil.ToFloat64(m.endpointRequests.WithLabelValues("metrics.listTeams", "success")))
+	require.Equal(t, 1.0, testutil.ToFloat64(m.endpointRequests.WithLabelValues("metrics.listTeams", "error")))
+	require.Equal(t, 2.0, testutil.ToFloat64(m.httpRequests.WithLabelValues("metrics.listTeams", "2xx")))
+	require.Equal(t, 1.0, testutil.ToFloat64(m.httpRequests.WithLabelValues("metrics.listTeams", "5xx")))
+	require.Equal(t, 1.0, testutil.ToFloat64(m.httpRequests.WithLabelValues("none", "4xx")))
+
+	m.SetMigrationVersion(15, false)
+	w := httptest.NewRecorder()
+	m.Handler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	body := w.Body.String()
+	require.True(t, strings.Contains(body, "sportech_migration_version 15"), body)
+	require.Contains(t, body, `sportech_http_request_duration_seconds_count{code="2xx",endpoint="metrics.listTeams"} 2`)
+}
+
+type listTeamsResponse struct {
+	Teams stream.Stream[string]
+}
+
+func TestStreamedResponsesAreMeasuredWhenDrained(t *testing.T) {
+	m := New()
+	var e endpoint.Endpoint = func(_ context.Context, request interface{}) (interface{}, error) {
+		return listTeamsResponse{Teams: func(_ context.Context, _ func(string) error) error {
+			return errors.New("connection reset")
+		}}, nil
+	}
+	response, err := m.Middleware()(e)(context.Background(), listTeamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 0, testutil.CollectAndCount(m.endpointRequests))
+
+	err = response.(listTeamsResponse).Teams(context.Background(), func(string) error { return nil })
+	require.Error(t, err)
+	require.Equal(t, 1.0, testutil.ToFloat64(m.endpointRequests.WithLabelValues("metrics.listTeams", "error")))
+	require.Equal(t, 0.0, testutil.ToFloat64(m.endpointRequests.WithLabelValues("metrics.listTeams", "success")))
+}
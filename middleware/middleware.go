@@ -3,7 +3,10 @@ package middleware
 import (
 	"github.com/go-kit/kit/endpoint"
 	"github.com/go-kit/log"
+	"github.com/rchauhan9/sportech/auth"
 	"github.com/rchauhan9/sportech/commons/go/logging"
+	"github.com/rchauhan9/sportech/metrics"
+	"github.com/rchauhan9/sportech/tracing"
 )
 
 func AddLogging(e endpoint.Endpoint, logger log.Logger) endpoint.Endpoint {
@@ -11,3 +14,28 @@ func AddLogging(e endpoint.Endpoint, logger log.Logger) endpoint.Endpoint {
 		logging.Middleware(logger),
 	)(e)
 }
+
+// AddMetrics counts and times requests to e. Add it after AddLogging, so that requests rejected
+// by AddAuthorization are counted too.
+func AddMetrics(e endpoint.Endpoint, m *metrics.Metrics) endpoint.Endpoint {
+	return endpoint.Chain(
+		m.Middleware(),
+	)(e)
+}
+
+// AddAuthorization requires callers to hold scope, and audit logs those who made requests with a
+// credential. Add it before AddLogging so that rejected requests are logged too.
+func AddAuthorization(e endpoint.Endpoint, authenticator auth.Authenticator, scope auth.Scope, logger log.Logger) endpoint.Endpoint {
+	return endpoint.Chain(
+		auth.Middleware(authenticator, scope),
+		auth.AuditMiddleware(logger),
+	)(e)
+}
+
+// AddTracing records requests to e as spans. Add it last, so that the span covers everything the
+// other middleware does.
+func AddTracing(e endpoint.Endpoint) endpoint.Endpoint {
+	return endpoint.Chain(
+		tracing.Middleware(),
+	)(e)
+}
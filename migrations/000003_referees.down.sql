@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS match_events;
+DROP TABLE IF EXISTS match_officials;
+DROP TABLE IF EXISTS matches;
+DROP TABLE IF EXISTS referees;
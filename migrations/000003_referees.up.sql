@@ -0,0 +1,32 @@
+CREATE TABLE IF NOT EXISTS referees (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    person_id UUID NOT NULL UNIQUE,
+    started DATE NOT NULL,
+    ended DATE
+);
+
+CREATE TABLE IF NOT EXISTS matches (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    home_team_id UUID NOT NULL,
+    away_team_id UUID NOT NULL,
+    league_id UUID NOT NULL,
+    stadium_id UUID NOT NULL,
+    kickoff TIMESTAMPTZ NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS match_officials (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    match_id UUID NOT NULL,
+    referee_id UUID NOT NULL,
+    role TEXT NOT NULL CHECK (role IN ('referee', 'assistant_referee', 'fourth_official', 'video_assistant_referee')),
+    UNIQUE (match_id, referee_id)
+);
+
+CREATE TABLE IF NOT EXISTS match_events (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    match_id UUID NOT NULL,
+    team_id UUID NOT NULL,
+    person_id UUID,
+    event_type TEXT NOT NULL CHECK (event_type IN ('yellow_card', 'red_card', 'penalty_awarded')),
+    minute INTEGER NOT NULL
+);
@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS stadiums_location_idx;
+
+ALTER TABLE stadiums
+    DROP COLUMN IF EXISTS year_opened,
+    DROP COLUMN IF EXISTS surface,
+    DROP COLUMN IF EXISTS elevation,
+    DROP COLUMN IF EXISTS longitude,
+    DROP COLUMN IF EXISTS latitude;
@@ -0,0 +1,8 @@
+ALTER TABLE stadiums
+    ADD COLUMN IF NOT EXISTS latitude DOUBLE PRECISION CHECK (latitude BETWEEN -90 AND 90),
+    ADD COLUMN IF NOT EXISTS longitude DOUBLE PRECISION CHECK (longitude BETWEEN -180 AND 180),
+    ADD COLUMN IF NOT EXISTS elevation INTEGER,
+    ADD COLUMN IF NOT EXISTS surface TEXT CHECK (surface IN ('grass', 'hybrid', 'artificial')),
+    ADD COLUMN IF NOT EXISTS year_opened INTEGER;
+
+CREATE INDEX IF NOT EXISTS stadiums_location_idx ON stadiums (latitude, longitude);
@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS team_stadium_tenancies (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    team_id UUID NOT NULL,
+    stadium_id UUID NOT NULL,
+    started DATE NOT NULL,
+    ended DATE,
+    UNIQUE (team_id, stadium_id, started),
+    CHECK (ended IS NULL OR ended >= started)
+);
+
+CREATE INDEX IF NOT EXISTS team_stadium_tenancies_team_idx ON team_stadium_tenancies (team_id, started);
+CREATE INDEX IF NOT EXISTS team_stadium_tenancies_stadium_idx ON team_stadium_tenancies (stadium_id, started);
+
+-- Only each team's current ground is known, so its tenancy is recorded from the year the team
+-- was founded. That is what teams already report for dates without a tenancy, by falling back to
+-- teams.stadium_id, so /teams and /stadiums/{id}/tenants agree until real history is added.
+INSERT INTO team_stadium_tenancies (team_id, stadium_id, started)
+SELECT id, stadium_id, make_date(year_founded, 1, 1)
+FROM teams
+ON CONFLICT DO NOTHING;
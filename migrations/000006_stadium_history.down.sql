@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS stadiums_name_idx;
+ALTER TABLE stadiums ADD CONSTRAINT stadiums_name_key UNIQUE (name);
+
+DROP TABLE IF EXISTS stadium_capacities;
+DROP TABLE IF EXISTS stadium_names;
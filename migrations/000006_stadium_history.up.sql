@@ -0,0 +1,26 @@
+CREATE TABLE IF NOT EXISTS stadium_names (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    stadium_id UUID NOT NULL,
+    name TEXT NOT NULL,
+    started DATE NOT NULL,
+    ended DATE,
+    UNIQUE (stadium_id, started),
+    CHECK (ended IS NULL OR ended >= started)
+);
+
+CREATE INDEX IF NOT EXISTS stadium_names_name_idx ON stadium_names (lower(name));
+
+CREATE TABLE IF NOT EXISTS stadium_capacities (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    stadium_id UUID NOT NULL,
+    capacity INTEGER NOT NULL,
+    started DATE NOT NULL,
+    ended DATE,
+    UNIQUE (stadium_id, started),
+    CHECK (ended IS NULL OR ended >= started)
+);
+
+-- Names are reused over time (a rebuilt ground, or a sponsor moving between venues), so the
+-- current name no longer has to be unique; historical names live in stadium_names.
+ALTER TABLE stadiums DROP CONSTRAINT IF EXISTS stadiums_name_key;
+CREATE INDEX IF NOT EXISTS stadiums_name_idx ON stadiums (lower(name));
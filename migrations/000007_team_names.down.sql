@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS teams_medium_name_idx;
+DROP TABLE IF EXISTS team_names;
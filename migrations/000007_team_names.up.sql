@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS team_names (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    team_id UUID NOT NULL,
+    full_name TEXT NOT NULL,
+    medium_name TEXT NOT NULL,
+    acronym TEXT NOT NULL,
+    nickname TEXT,
+    started DATE NOT NULL,
+    ended DATE,
+    UNIQUE (team_id, started),
+    CHECK (ended IS NULL OR ended >= started)
+);
+
+CREATE INDEX IF NOT EXISTS team_names_full_name_idx ON team_names (lower(full_name));
+CREATE INDEX IF NOT EXISTS team_names_medium_name_idx ON team_names (lower(medium_name));
+CREATE INDEX IF NOT EXISTS teams_medium_name_idx ON teams (lower(medium_name));
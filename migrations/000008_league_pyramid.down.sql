@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS league_memberships;
+DROP TABLE IF EXISTS league_standings;
+DROP TABLE IF EXISTS league_links;
+
+ALTER TABLE leagues DROP COLUMN IF EXISTS tier;
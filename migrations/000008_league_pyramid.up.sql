@@ -0,0 +1,30 @@
+ALTER TABLE leagues ADD COLUMN IF NOT EXISTS tier INTEGER CHECK (tier > 0);
+
+CREATE TABLE IF NOT EXISTS league_links (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    upper_league_id UUID NOT NULL,
+    lower_league_id UUID NOT NULL,
+    promotion_places INTEGER NOT NULL DEFAULT 0 CHECK (promotion_places >= 0),
+    relegation_places INTEGER NOT NULL DEFAULT 0 CHECK (relegation_places >= 0),
+    playoff_places INTEGER NOT NULL DEFAULT 0 CHECK (playoff_places >= 0),
+    UNIQUE (upper_league_id, lower_league_id),
+    CHECK (upper_league_id <> lower_league_id)
+);
+
+CREATE TABLE IF NOT EXISTS league_standings (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    league_id UUID NOT NULL,
+    season INTEGER NOT NULL,
+    team_id UUID NOT NULL,
+    position INTEGER NOT NULL CHECK (position > 0),
+    UNIQUE (league_id, season, team_id),
+    UNIQUE (league_id, season, position)
+);
+
+CREATE TABLE IF NOT EXISTS league_memberships (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    league_id UUID NOT NULL,
+    team_id UUID NOT NULL,
+    season INTEGER NOT NULL,
+    UNIQUE (team_id, season)
+);
@@ -0,0 +1,20 @@
+DROP INDEX IF EXISTS leagues_search_idx;
+DROP INDEX IF EXISTS leagues_name_trgm_idx;
+DROP INDEX IF EXISTS stadium_names_search_idx;
+DROP INDEX IF EXISTS stadium_names_name_trgm_idx;
+DROP INDEX IF EXISTS stadiums_search_idx;
+DROP INDEX IF EXISTS stadiums_name_trgm_idx;
+DROP INDEX IF EXISTS persons_search_idx;
+DROP INDEX IF EXISTS persons_name_trgm_idx;
+DROP INDEX IF EXISTS team_names_search_idx;
+DROP INDEX IF EXISTS team_names_full_name_trgm_idx;
+DROP INDEX IF EXISTS teams_search_idx;
+DROP INDEX IF EXISTS teams_nickname_trgm_idx;
+DROP INDEX IF EXISTS teams_medium_name_trgm_idx;
+DROP INDEX IF EXISTS teams_full_name_trgm_idx;
+
+DROP TEXT SEARCH CONFIGURATION IF EXISTS sportech_search;
+DROP FUNCTION IF EXISTS f_unaccent(TEXT);
+
+DROP EXTENSION IF EXISTS unaccent;
+DROP EXTENSION IF EXISTS pg_trgm;
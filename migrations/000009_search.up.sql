@@ -0,0 +1,41 @@
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+CREATE EXTENSION IF NOT EXISTS unaccent;
+
+-- unaccent() is only STABLE, so it can't be used in an index expression. Pinning the dictionary
+-- makes this wrapper safe to declare IMMUTABLE.
+CREATE OR REPLACE FUNCTION f_unaccent(TEXT) RETURNS TEXT AS $$
+    SELECT public.unaccent('public.unaccent', $1)
+$$ LANGUAGE sql IMMUTABLE PARALLEL SAFE STRICT;
+
+DROP TEXT SEARCH CONFIGURATION IF EXISTS sportech_search;
+CREATE TEXT SEARCH CONFIGURATION sportech_search (COPY = simple);
+ALTER TEXT SEARCH CONFIGURATION sportech_search
+    ALTER MAPPING FOR hword, hword_part, word WITH unaccent, simple;
+
+CREATE INDEX IF NOT EXISTS teams_full_name_trgm_idx ON teams USING GIN (f_unaccent(lower(full_name)) gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS teams_medium_name_trgm_idx ON teams USING GIN (f_unaccent(lower(medium_name)) gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS teams_nickname_trgm_idx ON teams USING GIN (f_unaccent(lower(nickname)) gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS teams_search_idx ON teams USING GIN (
+    to_tsvector('sportech_search', full_name || ' ' || medium_name || ' ' || coalesce(nickname, '') || ' ' || acronym)
+);
+
+CREATE INDEX IF NOT EXISTS team_names_full_name_trgm_idx ON team_names USING GIN (f_unaccent(lower(full_name)) gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS team_names_search_idx ON team_names USING GIN (
+    to_tsvector('sportech_search', full_name || ' ' || medium_name || ' ' || coalesce(nickname, '') || ' ' || acronym)
+);
+
+CREATE INDEX IF NOT EXISTS persons_name_trgm_idx ON persons USING GIN (
+    f_unaccent(lower(first_name || ' ' || coalesce(middle_names || ' ', '') || last_name)) gin_trgm_ops
+);
+CREATE INDEX IF NOT EXISTS persons_search_idx ON persons USING GIN (
+    to_tsvector('sportech_search', first_name || ' ' || coalesce(middle_names || ' ', '') || last_name)
+);
+
+CREATE INDEX IF NOT EXISTS stadiums_name_trgm_idx ON stadiums USING GIN (f_unaccent(lower(name)) gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS stadiums_search_idx ON stadiums USING GIN (to_tsvector('sportech_search', name));
+
+CREATE INDEX IF NOT EXISTS stadium_names_name_trgm_idx ON stadium_names USING GIN (f_unaccent(lower(name)) gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS stadium_names_search_idx ON stadium_names USING GIN (to_tsvector('sportech_search', name));
+
+CREATE INDEX IF NOT EXISTS leagues_name_trgm_idx ON leagues USING GIN (f_unaccent(lower(name)) gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS leagues_search_idx ON leagues USING GIN (to_tsvector('sportech_search', name));
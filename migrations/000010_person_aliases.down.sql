@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS persons_search_idx;
+CREATE INDEX IF NOT EXISTS persons_search_idx ON persons USING GIN (
+    to_tsvector('sportech_search', first_name || ' ' || coalesce(middle_names || ' ', '') || last_name)
+);
+DROP INDEX IF EXISTS persons_known_as_trgm_idx;
+
+DROP TABLE IF EXISTS person_aliases;
+
+ALTER TABLE persons DROP COLUMN IF EXISTS known_as;
@@ -0,0 +1,19 @@
+ALTER TABLE persons ADD COLUMN IF NOT EXISTS known_as TEXT;
+
+CREATE TABLE IF NOT EXISTS person_aliases (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    person_id UUID NOT NULL,
+    alias TEXT NOT NULL,
+    locale TEXT,
+    UNIQUE (person_id, alias, locale)
+);
+
+CREATE INDEX IF NOT EXISTS person_aliases_person_idx ON person_aliases (person_id);
+CREATE INDEX IF NOT EXISTS person_aliases_alias_trgm_idx ON person_aliases USING GIN (f_unaccent(lower(alias)) gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS person_aliases_search_idx ON person_aliases USING GIN (to_tsvector('sportech_search', alias));
+
+CREATE INDEX IF NOT EXISTS persons_known_as_trgm_idx ON persons USING GIN (f_unaccent(lower(known_as)) gin_trgm_ops);
+DROP INDEX IF EXISTS persons_search_idx;
+CREATE INDEX IF NOT EXISTS persons_search_idx ON persons USING GIN (
+    to_tsvector('sportech_search', first_name || ' ' || coalesce(middle_names || ' ', '') || last_name || ' ' || coalesce(known_as, ''))
+);
@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS person_redirects (
+    old_person_id UUID PRIMARY KEY,
+    new_person_id UUID NOT NULL,
+    merged_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS person_redirects_new_person_idx ON person_redirects (new_person_id);
@@ -0,0 +1,83 @@
+DROP TRIGGER IF EXISTS countries_touch_table_version ON countries;
+DROP TRIGGER IF EXISTS countries_set_updated_at ON countries;
+ALTER TABLE countries DROP COLUMN IF EXISTS updated_at;
+
+DROP TRIGGER IF EXISTS leagues_touch_table_version ON leagues;
+DROP TRIGGER IF EXISTS leagues_set_updated_at ON leagues;
+ALTER TABLE leagues DROP COLUMN IF EXISTS updated_at;
+
+DROP TRIGGER IF EXISTS league_links_touch_table_version ON league_links;
+DROP TRIGGER IF EXISTS league_links_set_updated_at ON league_links;
+ALTER TABLE league_links DROP COLUMN IF EXISTS updated_at;
+
+DROP TRIGGER IF EXISTS league_memberships_touch_table_version ON league_memberships;
+DROP TRIGGER IF EXISTS league_memberships_set_updated_at ON league_memberships;
+ALTER TABLE league_memberships DROP COLUMN IF EXISTS updated_at;
+
+DROP TRIGGER IF EXISTS league_standings_touch_table_version ON league_standings;
+DROP TRIGGER IF EXISTS league_standings_set_updated_at ON league_standings;
+ALTER TABLE league_standings DROP COLUMN IF EXISTS updated_at;
+
+DROP TRIGGER IF EXISTS stadiums_touch_table_version ON stadiums;
+DROP TRIGGER IF EXISTS stadiums_set_updated_at ON stadiums;
+ALTER TABLE stadiums DROP COLUMN IF EXISTS updated_at;
+
+DROP TRIGGER IF EXISTS stadium_names_touch_table_version ON stadium_names;
+DROP TRIGGER IF EXISTS stadium_names_set_updated_at ON stadium_names;
+ALTER TABLE stadium_names DROP COLUMN IF EXISTS updated_at;
+
+DROP TRIGGER IF EXISTS stadium_capacities_touch_table_version ON stadium_capacities;
+DROP TRIGGER IF EXISTS stadium_capacities_set_updated_at ON stadium_capacities;
+ALTER TABLE stadium_capacities DROP COLUMN IF EXISTS updated_at;
+
+DROP TRIGGER IF EXISTS teams_touch_table_version ON teams;
+DROP TRIGGER IF EXISTS teams_set_updated_at ON teams;
+ALTER TABLE teams DROP COLUMN IF EXISTS updated_at;
+
+DROP TRIGGER IF EXISTS team_names_touch_table_version ON team_names;
+DROP TRIGGER IF EXISTS team_names_set_updated_at ON team_names;
+ALTER TABLE team_names DROP COLUMN IF EXISTS updated_at;
+
+DROP TRIGGER IF EXISTS team_stadium_tenancies_touch_table_version ON team_stadium_tenancies;
+DROP TRIGGER IF EXISTS team_stadium_tenancies_set_updated_at ON team_stadium_tenancies;
+ALTER TABLE team_stadium_tenancies DROP COLUMN IF EXISTS updated_at;
+
+DROP TRIGGER IF EXISTS persons_touch_table_version ON persons;
+DROP TRIGGER IF EXISTS persons_set_updated_at ON persons;
+ALTER TABLE persons DROP COLUMN IF EXISTS updated_at;
+
+DROP TRIGGER IF EXISTS person_aliases_touch_table_version ON person_aliases;
+DROP TRIGGER IF EXISTS person_aliases_set_updated_at ON person_aliases;
+ALTER TABLE person_aliases DROP COLUMN IF EXISTS updated_at;
+
+DROP TRIGGER IF EXISTS person_redirects_touch_table_version ON person_redirects;
+DROP TRIGGER IF EXISTS person_redirects_set_updated_at ON person_redirects;
+ALTER TABLE person_redirects DROP COLUMN IF EXISTS updated_at;
+
+DROP TRIGGER IF EXISTS team_players_touch_table_version ON team_players;
+DROP TRIGGER IF EXISTS team_players_set_updated_at ON team_players;
+ALTER TABLE team_players DROP COLUMN IF EXISTS updated_at;
+
+DROP TRIGGER IF EXISTS team_managers_touch_table_version ON team_managers;
+DROP TRIGGER IF EXISTS team_managers_set_updated_at ON team_managers;
+ALTER TABLE team_managers DROP COLUMN IF EXISTS updated_at;
+
+DROP TRIGGER IF EXISTS referees_touch_table_version ON referees;
+DROP TRIGGER IF EXISTS referees_set_updated_at ON referees;
+ALTER TABLE referees DROP COLUMN IF EXISTS updated_at;
+
+DROP TRIGGER IF EXISTS matches_touch_table_version ON matches;
+DROP TRIGGER IF EXISTS matches_set_updated_at ON matches;
+ALTER TABLE matches DROP COLUMN IF EXISTS updated_at;
+
+DROP TRIGGER IF EXISTS match_officials_touch_table_version ON match_officials;
+DROP TRIGGER IF EXISTS match_officials_set_updated_at ON match_officials;
+ALTER TABLE match_officials DROP COLUMN IF EXISTS updated_at;
+
+DROP TRIGGER IF EXISTS match_events_touch_table_version ON match_events;
+DROP TRIGGER IF EXISTS match_events_set_updated_at ON match_events;
+ALTER TABLE match_events DROP COLUMN IF EXISTS updated_at;
+
+DROP FUNCTION IF EXISTS touch_table_version();
+DROP TABLE IF EXISTS table_versions;
+DROP FUNCTION IF EXISTS set_updated_at();
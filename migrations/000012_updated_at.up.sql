@@ -0,0 +1,162 @@
+-- updated_at records when each row last changed. table_versions records when each table last
+-- changed at all, including deletes, so HTTP responses can be validated with one cheap lookup.
+CREATE OR REPLACE FUNCTION set_updated_at() RETURNS TRIGGER AS $$
+BEGIN
+    NEW.updated_at = now();
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TABLE IF NOT EXISTS table_versions (
+    table_name TEXT PRIMARY KEY,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE OR REPLACE FUNCTION touch_table_version() RETURNS TRIGGER AS $$
+BEGIN
+    INSERT INTO table_versions (table_name, updated_at)
+    VALUES (TG_TABLE_NAME, clock_timestamp())
+    ON CONFLICT (table_name) DO UPDATE SET updated_at = EXCLUDED.updated_at;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+ALTER TABLE countries ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ NOT NULL DEFAULT now();
+CREATE TRIGGER countries_set_updated_at BEFORE UPDATE ON countries
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+CREATE TRIGGER countries_touch_table_version AFTER INSERT OR UPDATE OR DELETE OR TRUNCATE ON countries
+    FOR EACH STATEMENT EXECUTE FUNCTION touch_table_version();
+INSERT INTO table_versions (table_name) VALUES ('countries') ON CONFLICT DO NOTHING;
+
+ALTER TABLE leagues ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ NOT NULL DEFAULT now();
+CREATE TRIGGER leagues_set_updated_at BEFORE UPDATE ON leagues
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+CREATE TRIGGER leagues_touch_table_version AFTER INSERT OR UPDATE OR DELETE OR TRUNCATE ON leagues
+    FOR EACH STATEMENT EXECUTE FUNCTION touch_table_version();
+INSERT INTO table_versions (table_name) VALUES ('leagues') ON CONFLICT DO NOTHING;
+
+ALTER TABLE league_links ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ NOT NULL DEFAULT now();
+CREATE TRIGGER league_links_set_updated_at BEFORE UPDATE ON league_links
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+CREATE TRIGGER league_links_touch_table_version AFTER INSERT OR UPDATE OR DELETE OR TRUNCATE ON league_links
+    FOR EACH STATEMENT EXECUTE FUNCTION touch_table_version();
+INSERT INTO table_versions (table_name) VALUES ('league_links') ON CONFLICT DO NOTHING;
+
+ALTER TABLE league_memberships ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ NOT NULL DEFAULT now();
+CREATE TRIGGER league_memberships_set_updated_at BEFORE UPDATE ON league_memberships
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+CREATE TRIGGER league_memberships_touch_table_version AFTER INSERT OR UPDATE OR DELETE OR TRUNCATE ON league_memberships
+    FOR EACH STATEMENT EXECUTE FUNCTION touch_table_version();
+INSERT INTO table_versions (table_name) VALUES ('league_memberships') ON CONFLICT DO NOTHING;
+
+ALTER TABLE league_standings ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ NOT NULL DEFAULT now();
+CREATE TRIGGER league_standings_set_updated_at BEFORE UPDATE ON league_standings
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+CREATE TRIGGER league_standings_touch_table_version AFTER INSERT OR UPDATE OR DELETE OR TRUNCATE ON league_standings
+    FOR EACH STATEMENT EXECUTE FUNCTION touch_table_version();
+INSERT INTO table_versions (table_name) VALUES ('league_standings') ON CONFLICT DO NOTHING;
+
+ALTER TABLE stadiums ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ NOT NULL DEFAULT now();
+CREATE TRIGGER stadiums_set_updated_at BEFORE UPDATE ON stadiums
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+CREATE TRIGGER stadiums_touch_table_version AFTER INSERT OR UPDATE OR DELETE OR TRUNCATE ON stadiums
+    FOR EACH STATEMENT EXECUTE FUNCTION touch_table_version();
+INSERT INTO table_versions (table_name) VALUES ('stadiums') ON CONFLICT DO NOTHING;
+
+ALTER TABLE stadium_names ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ NOT NULL DEFAULT now();
+CREATE TRIGGER stadium_names_set_updated_at BEFORE UPDATE ON stadium_names
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+CREATE TRIGGER stadium_names_touch_table_version AFTER INSERT OR UPDATE OR DELETE OR TRUNCATE ON stadium_names
+    FOR EACH STATEMENT EXECUTE FUNCTION touch_table_version();
+INSERT INTO table_versions (table_name) VALUES ('stadium_names') ON CONFLICT DO NOTHING;
+
+ALTER TABLE stadium_capacities ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ NOT NULL DEFAULT now();
+CREATE TRIGGER stadium_capacities_set_updated_at BEFORE UPDATE ON stadium_capacities
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+CREATE TRIGGER stadium_capacities_touch_table_version AFTER INSERT OR UPDATE OR DELETE OR TRUNCATE ON stadium_capacities
+    FOR EACH STATEMENT EXECUTE FUNCTION touch_table_version();
+INSERT INTO table_versions (table_name) VALUES ('stadium_capacities') ON CONFLICT DO NOTHING;
+
+ALTER TABLE teams ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ NOT NULL DEFAULT now();
+CREATE TRIGGER teams_set_updated_at BEFORE UPDATE ON teams
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+CREATE TRIGGER teams_touch_table_version AFTER INSERT OR UPDATE OR DELETE OR TRUNCATE ON teams
+    FOR EACH STATEMENT EXECUTE FUNCTION touch_table_version();
+INSERT INTO table_versions (table_name) VALUES ('teams') ON CONFLICT DO NOTHING;
+
+ALTER TABLE team_names ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ NOT NULL DEFAULT now();
+CREATE TRIGGER team_names_set_updated_at BEFORE UPDATE ON team_names
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+CREATE TRIGGER team_names_touch_table_version AFTER INSERT OR UPDATE OR DELETE OR TRUNCATE ON team_names
+    FOR EACH STATEMENT EXECUTE FUNCTION touch_table_version();
+INSERT INTO table_versions (table_name) VALUES ('team_names') ON CONFLICT DO NOTHING;
+
+ALTER TABLE team_stadium_tenancies ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ NOT NULL DEFAULT now();
+CREATE TRIGGER team_stadium_tenancies_set_updated_at BEFORE UPDATE ON team_stadium_tenancies
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+CREATE TRIGGER team_stadium_tenancies_touch_table_version AFTER INSERT OR UPDATE OR DELETE OR TRUNCATE ON team_stadium_tenancies
+    FOR EACH STATEMENT EXECUTE FUNCTION touch_table_version();
+INSERT INTO table_versions (table_name) VALUES ('team_stadium_tenancies') ON CONFLICT DO NOTHING;
+
+ALTER TABLE persons ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ NOT NULL DEFAULT now();
+CREATE TRIGGER persons_set_updated_at BEFORE UPDATE ON persons
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+CREATE TRIGGER persons_touch_table_version AFTER INSERT OR UPDATE OR DELETE OR TRUNCATE ON persons
+    FOR EACH STATEMENT EXECUTE FUNCTION touch_table_version();
+INSERT INTO table_versions (table_name) VALUES ('persons') ON CONFLICT DO NOTHING;
+
+ALTER TABLE person_aliases ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ NOT NULL DEFAULT now();
+CREATE TRIGGER person_aliases_set_updated_at BEFORE UPDATE ON person_aliases
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+CREATE TRIGGER person_aliases_touch_table_version AFTER INSERT OR UPDATE OR DELETE OR TRUNCATE ON person_aliases
+    FOR EACH STATEMENT EXECUTE FUNCTION touch_table_version();
+INSERT INTO table_versions (table_name) VALUES ('person_aliases') ON CONFLICT DO NOTHING;
+
+ALTER TABLE person_redirects ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ NOT NULL DEFAULT now();
+CREATE TRIGGER person_redirects_set_updated_at BEFORE UPDATE ON person_redirects
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+CREATE TRIGGER person_redirects_touch_table_version AFTER INSERT OR UPDATE OR DELETE OR TRUNCATE ON person_redirects
+    FOR EACH STATEMENT EXECUTE FUNCTION touch_table_version();
+INSERT INTO table_versions (table_name) VALUES ('person_redirects') ON CONFLICT DO NOTHING;
+
+ALTER TABLE team_players ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ NOT NULL DEFAULT now();
+CREATE TRIGGER team_players_set_updated_at BEFORE UPDATE ON team_players
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+CREATE TRIGGER team_players_touch_table_version AFTER INSERT OR UPDATE OR DELETE OR TRUNCATE ON team_players
+    FOR EACH STATEMENT EXECUTE FUNCTION touch_table_version();
+INSERT INTO table_versions (table_name) VALUES ('team_players') ON CONFLICT DO NOTHING;
+
+ALTER TABLE team_managers ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ NOT NULL DEFAULT now();
+CREATE TRIGGER team_managers_set_updated_at BEFORE UPDATE ON team_managers
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+CREATE TRIGGER team_managers_touch_table_version AFTER INSERT OR UPDATE OR DELETE OR TRUNCATE ON team_managers
+    FOR EACH STATEMENT EXECUTE FUNCTION touch_table_version();
+INSERT INTO table_versions (table_name) VALUES ('team_managers') ON CONFLICT DO NOTHING;
+
+ALTER TABLE referees ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ NOT NULL DEFAULT now();
+CREATE TRIGGER referees_set_updated_at BEFORE UPDATE ON referees
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+CREATE TRIGGER referees_touch_table_version AFTER INSERT OR UPDATE OR DELETE OR TRUNCATE ON referees
+    FOR EACH STATEMENT EXECUTE FUNCTION touch_table_version();
+INSERT INTO table_versions (table_name) VALUES ('referees') ON CONFLICT DO NOTHING;
+
+ALTER TABLE matches ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ NOT NULL DEFAULT now();
+CREATE TRIGGER matches_set_updated_at BEFORE UPDATE ON matches
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+CREATE TRIGGER matches_touch_table_version AFTER INSERT OR UPDATE OR DELETE OR TRUNCATE ON matches
+    FOR EACH STATEMENT EXECUTE FUNCTION touch_table_version();
+INSERT INTO table_versions (table_name) VALUES ('matches') ON CONFLICT DO NOTHING;
+
+ALTER TABLE match_officials ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ NOT NULL DEFAULT now();
+CREATE TRIGGER match_officials_set_updated_at BEFORE UPDATE ON match_officials
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+CREATE TRIGGER match_officials_touch_table_version AFTER INSERT OR UPDATE OR DELETE OR TRUNCATE ON match_officials
+    FOR EACH STATEMENT EXECUTE FUNCTION touch_table_version();
+INSERT INTO table_versions (table_name) VALUES ('match_officials') ON CONFLICT DO NOTHING;
+
+ALTER TABLE match_events ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ NOT NULL DEFAULT now();
+CREATE TRIGGER match_events_set_updated_at BEFORE UPDATE ON match_events
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+CREATE TRIGGER match_events_touch_table_version AFTER INSERT OR UPDATE OR DELETE OR TRUNCATE ON match_events
+    FOR EACH STATEMENT EXECUTE FUNCTION touch_table_version();
+INSERT INTO table_versions (table_name) VALUES ('match_events') ON CONFLICT DO NOTHING;
@@ -0,0 +1,11 @@
+-- Tell listening instances which table changed so they can drop what they cached from it. The
+-- notification is only delivered if the transaction commits.
+CREATE OR REPLACE FUNCTION touch_table_version() RETURNS TRIGGER AS $$
+BEGIN
+    INSERT INTO table_versions (table_name, updated_at)
+    VALUES (TG_TABLE_NAME, clock_timestamp())
+    ON CONFLICT (table_name) DO UPDATE SET updated_at = EXCLUDED.updated_at;
+    PERFORM pg_notify('table_changed', TG_TABLE_NAME);
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
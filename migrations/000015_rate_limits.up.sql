@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS rate_limit_buckets (
+    bucket_key TEXT PRIMARY KEY,
+    tokens DOUBLE PRECISION NOT NULL,
+    allowed BOOLEAN NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS rate_limit_quotas (
+    quota_key TEXT NOT NULL,
+    day DATE NOT NULL,
+    used BIGINT NOT NULL,
+    PRIMARY KEY (quota_key, day)
+);
@@ -0,0 +1,202 @@
+package openapi
+
+import (
+	"context"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/pkg/errors"
+	"github.com/rchauhan9/sportech/auth"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+// Route describes one operation served over HTTP. Each package declares its routes next to its
+// handler, using the same request and response types the handler encodes, so the schemas are
+// reflected from the code rather than written by hand.
+type Route struct {
+	Method      string
+	Path        string
+	Tag         string
+	Summary     string
+	Description string
+	Query       []Parameter
+	// Request is a value of the type decoded from the JSON body, or nil if there is no body.
+	Request interface{}
+	// Response is a value of the type encoded on success.
+	Response interface{}
+	// Formats marks list routes that can also be written as CSV or NDJSON, chosen with
+	// FormatParam or the Accept header.
+	Formats bool
+	// Scope is the scope a caller needs, or "" for public reads, which anonymous callers may make
+	// unless the server is configured otherwise.
+	Scope auth.Scope
+}
+
+// Parameter is a query parameter. Path parameters are taken from the route's path.
+type Parameter struct {
+	Name        string
+	Description string
+	Type        string
+	Format      string
+	Required    bool
+}
+
+// QueryParam describes a query parameter of an OpenAPI type such as "string" or "integer".
+func QueryParam(name string, paramType string, description string) Parameter {
+	return Parameter{Name: name, Type: paramType, Description: description}
+}
+
+// AsOfParam is the optional asOf date shared by routes that render history.
+var AsOfParam = Parameter{
+	Name:        "asOf",
+	Type:        "string",
+	Format:      "date",
+	Description: "Render the resource as it was on this date (YYYY-MM-DD). Defaults to today.",
+}
+
+// FormatParam is the optional format override shared by list routes.
+var FormatParam = Parameter{
+	Name:        "format",
+	Type:        "string",
+	Description: "Write the list as json, csv or ndjson. Overrides the Accept header; defaults to json.",
+}
+
+var pathParamPattern = regexp.MustCompile(`{([^}]+)}`)
+
+// NewSpec builds and validates an OpenAPI 3 document for routes.
+func NewSpec(title string, version string, routes ...[]Route) (*openapi3.T, error) {
+	b := newSchemaBuilder()
+
+	errorSchema := openapi3.NewObjectSchema().WithProperty("error", openapi3.NewStringSchema())
+	errorSchema.Required = []string{"error"}
+	b.components["Error"] = openapi3.NewSchemaRef("", errorSchema)
+	errorRef := openapi3.NewSchemaRef("#/components/schemas/Error", errorSchema)
+
+	doc := &openapi3.T{
+		OpenAPI: "3.0.3",
+		Info:    &openapi3.Info{Title: title, Version: version},
+		Paths:   openapi3.Paths{},
+		Components: openapi3.Components{
+			Schemas: b.components,
+			SecuritySchemes: openapi3.SecuritySchemes{
+				"apiKey": &openapi3.SecuritySchemeRef{
+					Value: openapi3.NewSecurityScheme().WithType("apiKey").WithIn("header").WithName("X-API-Key"),
+				},
+				"bearer": &openapi3.SecuritySchemeRef{
+					Value: openapi3.NewSecurityScheme().WithType("http").WithScheme("bearer").
+						WithDescription("An API key, or a JWT from the identity provider."),
+				},
+			},
+		},
+	}
+
+	for _, group := range routes {
+		for _, route := range group {
+			operation := &openapi3.Operation{
+				Tags:        []string{route.Tag},
+				Summary:     route.Summary,
+				Description: route.Description,
+				OperationID: operationID(route),
+				Responses:   openapi3.Responses{},
+				Security:    security(route),
+			}
+			if route.Scope != "" {
+				operation.Description = strings.TrimSpace(operation.Description + " Requires the " + string(route.Scope) + " scope.")
+			}
+
+			for _, match := range pathParamPattern.FindAllStringSubmatch(route.Path, -1) {
+				param := openapi3.NewPathParameter(match[1]).WithSchema(openapi3.NewStringSchema())
+				operation.Parameters = append(operation.Parameters, &openapi3.ParameterRef{Value: param})
+			}
+			for _, query := range route.Query {
+				schema := &openapi3.Schema{Type: query.Type, Format: query.Format}
+				param := openapi3.NewQueryParameter(query.Name).
+					WithDescription(query.Description).
+					WithRequired(query.Required).
+					WithSchema(schema)
+				operation.Parameters = append(operation.Parameters, &openapi3.ParameterRef{Value: param})
+			}
+
+			if route.Request != nil {
+				body := openapi3.NewRequestBody().WithRequired(true).WithJSONSchemaRef(b.schemaFor(route.Request))
+				operation.RequestBody = &openapi3.RequestBodyRef{Value: body}
+			}
+
+			success := openapi3.NewResponse().WithDescription("OK")
+			if route.Response != nil {
+				success = success.WithJSONSchemaRef(b.schemaFor(route.Response))
+			}
+			if route.Formats {
+				if success.Content == nil {
+					success.Content = openapi3.NewContent()
+				}
+				success.Content["text/csv"] = openapi3.NewMediaType().WithSchema(&openapi3.Schema{
+					Type:        openapi3.TypeString,
+					Description: "A header row of field names, then one row per item.",
+				})
+				success.Content["application/x-ndjson"] = openapi3.NewMediaType().WithSchema(&openapi3.Schema{
+					Type:        openapi3.TypeString,
+					Description: "One JSON object per line.",
+				})
+			}
+			operation.Responses["200"] = &openapi3.ResponseRef{Value: success}
+			operation.Responses["400"] = &openapi3.ResponseRef{
+				Value: openapi3.NewResponse().WithDescription("Invalid argument").WithJSONSchemaRef(errorRef),
+			}
+			operation.Responses["401"] = &openapi3.ResponseRef{
+				Value: openapi3.NewResponse().WithDescription("Unknown or revoked credential").WithJSONSchemaRef(errorRef),
+			}
+			operation.Responses["403"] = &openapi3.ResponseRef{
+				Value: openapi3.NewResponse().WithDescription("Missing scope").WithJSONSchemaRef(errorRef),
+			}
+			operation.Responses["429"] = &openapi3.ResponseRef{
+				Value: openapi3.NewResponse().WithDescription("Rate limit or daily quota exceeded").WithJSONSchemaRef(errorRef),
+			}
+			operation.Responses["500"] = &openapi3.ResponseRef{
+				Value: openapi3.NewResponse().WithDescription("Internal error").WithJSONSchemaRef(errorRef),
+			}
+
+			item, ok := doc.Paths[route.Path]
+			if !ok {
+				item = &openapi3.PathItem{}
+				doc.Paths[route.Path] = item
+			}
+			if item.GetOperation(route.Method) != nil {
+				return nil, errors.Errorf("route %s %s is described twice", route.Method, route.Path)
+			}
+			item.SetOperation(route.Method, operation)
+		}
+	}
+
+	if err := doc.Validate(context.Background()); err != nil {
+		return nil, errors.Wrap(err, "invalid openapi document")
+	}
+	return doc, nil
+}
+
+// security accepts either scheme, and no credential at all for public reads.
+func security(route Route) *openapi3.SecurityRequirements {
+	requirements := openapi3.SecurityRequirements{
+		openapi3.NewSecurityRequirement().Authenticate("apiKey"),
+		openapi3.NewSecurityRequirement().Authenticate("bearer"),
+	}
+	if route.Scope == "" {
+		requirements = append(requirements, openapi3.NewSecurityRequirement())
+	}
+	return &requirements
+}
+
+// operationID derives an ID such as "getTeamsId" from the method and path.
+func operationID(route Route) string {
+	var sb strings.Builder
+	sb.WriteString(strings.ToLower(route.Method))
+	for _, part := range strings.FieldsFunc(route.Path, func(r rune) bool {
+		return r == '/' || r == '{' || r == '}' || r == '-'
+	}) {
+		sb.WriteString(strings.ToUpper(part[:1]) + part[1:])
+	}
+	if route.Method == http.MethodGet && strings.HasSuffix(route.Path, "/") {
+		sb.WriteString("List")
+	}
+	return sb.String()
+}
@@ -0,0 +1,129 @@
+package openapi_test
+
+import (
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/gorilla/mux"
+	"github.com/rchauhan9/sportech/apikeys"
+	"github.com/rchauhan9/sportech/graph"
+	"github.com/rchauhan9/sportech/leagues"
+	"github.com/rchauhan9/sportech/managers"
+	"github.com/rchauhan9/sportech/openapi"
+	"github.com/rchauhan9/sportech/persons"
+	"github.com/rchauhan9/sportech/players"
+	"github.com/rchauhan9/sportech/referees"
+	"github.com/rchauhan9/sportech/search"
+	"github.com/rchauhan9/sportech/stadiums"
+	"github.com/rchauhan9/sportech/teams"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"testing"
+)
+
+func allRoutes() [][]openapi.Route {
+	return [][]openapi.Route{
+		teams.Routes,
+		stadiums.Routes,
+		leagues.Routes,
+		persons.Routes,
+		managers.Routes,
+		players.Routes,
+		referees.Routes,
+		search.Routes,
+		graph.Routes,
+		apikeys.Routes,
+	}
+}
+
+// TestRoutesMatchHandlers fails when a route is added to, or removed from, a handler without
+// its description being updated.
+func TestRoutesMatchHandlers(t *testing.T) {
+	handlers := []http.Handler{
+		teams.MakeHandler(nil, nil, nil),
+		stadiums.MakeHandler(nil, nil, nil),
+		leagues.MakeHandler(nil, nil, nil, nil),
+		persons.MakeHandler(nil, nil, nil),
+		managers.MakeHandler(nil, nil),
+		players.MakeHandler(nil, nil),
+		referees.MakeHandler(nil, nil, nil, nil),
+		search.MakeHandler(nil),
+		graph.MakeHandler(nil),
+		apikeys.MakeHandler(nil, nil, nil, nil),
+	}
+
+	var served []string
+	for _, handler := range handlers {
+		err := handler.(*mux.Router).Walk(func(route *mux.Route, _ *mux.Router, _ []*mux.Route) error {
+			path, err := route.GetPathTemplate()
+			if err != nil {
+				return err
+			}
+			methods, err := route.GetMethods()
+			if err != nil {
+				return err
+			}
+			for _, method := range methods {
+				served = append(served, method+" "+path)
+			}
+			return nil
+		})
+		require.NoError(t, err)
+	}
+
+	var described []string
+	for _, group := range allRoutes() {
+		for _, route := range group {
+			described = append(described, route.Method+" "+route.Path)
+		}
+	}
+
+	sort.Strings(served)
+	sort.Strings(described)
+	require.Equal(t, served, described)
+}
+
+func TestNewSpec(t *testing.T) {
+	spec, err := openapi.NewSpec("Sportech API", "1.0.0", allRoutes()...)
+	require.NoError(t, err)
+
+	for _, name := range []string{"Team", "Stadium", "League", "Player", "Manager", "Referee", "Person", "Alias"} {
+		require.Contains(t, spec.Components.Schemas, name)
+	}
+
+	team := spec.Components.Schemas["Team"].Value
+	require.Contains(t, team.Properties, "fullName")
+	require.True(t, team.Properties["nickname"].Value.Nullable)
+
+	getTeam := spec.Paths.Find("/teams/{id}").Get
+	require.Equal(t, "id", getTeam.Parameters[0].Value.Name)
+	require.Equal(t, "path", getTeam.Parameters[0].Value.In)
+	require.Equal(t, "#/components/schemas/Team", getTeam.Responses["200"].Value.Content["application/json"].Schema.Value.Properties["team"].Ref)
+
+	merge := spec.Paths.Find("/persons/{id}/merge").Post
+	body := merge.RequestBody.Value.Content["application/json"].Schema.Value
+	require.Contains(t, body.Properties, "duplicateIds")
+	require.NotContains(t, body.Properties, "SurvivorID")
+
+	require.Contains(t, spec.Components.SecuritySchemes, "apiKey")
+	require.Contains(t, *getTeam.Security, openapi3.SecurityRequirement{})
+	require.NotContains(t, *merge.Security, openapi3.SecurityRequirement{})
+	require.Contains(t, merge.Description, "Requires the admin scope.")
+}
+
+func TestSpecIsServed(t *testing.T) {
+	spec, err := openapi.NewSpec("Sportech API", "1.0.0", allRoutes()...)
+	require.NoError(t, err)
+	handler, err := openapi.MakeHandler(spec)
+	require.NoError(t, err)
+
+	for path, contentType := range map[string]string{
+		"/openapi.json": "application/json; charset=utf-8",
+		"/docs":         "text/html; charset=utf-8",
+	} {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		require.Equal(t, contentType, rec.Header().Get("Content-Type"), path)
+		require.NotEmpty(t, rec.Body.Bytes(), path)
+	}
+}
@@ -0,0 +1,137 @@
+package openapi
+
+import (
+	"github.com/getkin/kin-openapi/openapi3"
+	"go/ast"
+	"path"
+	"reflect"
+	"strings"
+	"time"
+)
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// itemTyper is implemented by stream.Stream, which is written out as a JSON array.
+type itemTyper interface {
+	ItemType() reflect.Type
+}
+
+var itemTyperType = reflect.TypeOf((*itemTyper)(nil)).Elem()
+
+// schemaBuilder reflects Go types into schemas. Exported named structs become components so
+// they appear by name, e.g. Team, while the unexported request and response wrappers are
+// inlined.
+type schemaBuilder struct {
+	components openapi3.Schemas
+	names      map[reflect.Type]string
+}
+
+func newSchemaBuilder() *schemaBuilder {
+	return &schemaBuilder{
+		components: openapi3.Schemas{},
+		names:      map[reflect.Type]string{},
+	}
+}
+
+func (b *schemaBuilder) schemaFor(value interface{}) *openapi3.SchemaRef {
+	return b.ref(reflect.TypeOf(value))
+}
+
+func (b *schemaBuilder) ref(t reflect.Type) *openapi3.SchemaRef {
+	nullable := false
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+		nullable = true
+	}
+
+	if t.Kind() == reflect.Struct && t != timeType && ast.IsExported(t.Name()) {
+		name := b.component(t)
+		return openapi3.NewSchemaRef("#/components/schemas/"+name, b.components[name].Value)
+	}
+
+	schema := b.inline(t)
+	schema.Nullable = nullable || schema.Nullable
+	return openapi3.NewSchemaRef("", schema)
+}
+
+func (b *schemaBuilder) component(t reflect.Type) string {
+	if name, ok := b.names[t]; ok {
+		return name
+	}
+
+	name := t.Name()
+	if _, taken := b.components[name]; taken {
+		pkg := path.Base(t.PkgPath())
+		name = strings.ToUpper(pkg[:1]) + pkg[1:] + name
+	}
+	b.names[t] = name
+	// Register the schema before filling it in so self-referencing types terminate.
+	schema := &openapi3.Schema{}
+	b.components[name] = openapi3.NewSchemaRef("", schema)
+	*schema = *b.inline(t)
+	return name
+}
+
+func (b *schemaBuilder) inline(t reflect.Type) *openapi3.Schema {
+	switch t.Kind() {
+	case reflect.Bool:
+		return openapi3.NewBoolSchema()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return openapi3.NewIntegerSchema()
+	case reflect.Int32:
+		return openapi3.NewInt32Schema()
+	case reflect.Int64, reflect.Uint64:
+		return openapi3.NewInt64Schema()
+	case reflect.Float32, reflect.Float64:
+		return openapi3.NewFloat64Schema()
+	case reflect.String:
+		return openapi3.NewStringSchema()
+	case reflect.Slice, reflect.Array:
+		schema := openapi3.NewArraySchema()
+		schema.Items = b.ref(t.Elem())
+		// encoding/json writes nil slices as null.
+		schema.Nullable = t.Kind() == reflect.Slice
+		return schema
+	case reflect.Map:
+		schema := openapi3.NewObjectSchema()
+		schema.AdditionalProperties = b.ref(t.Elem())
+		schema.Nullable = true
+		return schema
+	case reflect.Func:
+		if t.Implements(itemTyperType) {
+			schema := openapi3.NewArraySchema()
+			schema.Items = b.ref(reflect.Zero(t).Interface().(itemTyper).ItemType())
+			return schema
+		}
+		return &openapi3.Schema{}
+	case reflect.Struct:
+		if t == timeType {
+			return openapi3.NewDateTimeSchema()
+		}
+		return b.object(t)
+	default:
+		return &openapi3.Schema{}
+	}
+}
+
+func (b *schemaBuilder) object(t reflect.Type) *openapi3.Schema {
+	schema := openapi3.NewObjectSchema()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name, opts, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		schema.WithPropertyRef(name, b.ref(field.Type))
+		if !strings.Contains(opts, "omitempty") {
+			schema.Required = append(schema.Required, name)
+		}
+	}
+	return schema
+}
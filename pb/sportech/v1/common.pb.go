@@ -0,0 +1,158 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1-devel
+// 	protoc        (unknown)
+// source: sportech/v1/common.proto
+
+package sportechv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Another name a person goes by. Locale is a BCP 47 tag such as "pt-BR", or unset if the alias
+// is used everywhere.
+type Alias struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Locale *string `protobuf:"bytes,2,opt,name=locale,proto3,oneof" json:"locale,omitempty"`
+}
+
+func (x *Alias) Reset() {
+	*x = Alias{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sportech_v1_common_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Alias) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Alias) ProtoMessage() {}
+
+func (x *Alias) ProtoReflect() protoreflect.Message {
+	mi := &file_sportech_v1_common_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Alias.ProtoReflect.Descriptor instead.
+func (*Alias) Descriptor() ([]byte, []int) {
+	return file_sportech_v1_common_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Alias) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Alias) GetLocale() string {
+	if x != nil && x.Locale != nil {
+		return *x.Locale
+	}
+	return ""
+}
+
+var File_sportech_v1_common_proto protoreflect.FileDescriptor
+
+var file_sportech_v1_common_proto_rawDesc = []byte{
+	0x0a, 0x18, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x63, 0x68, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x73, 0x70, 0x6f, 0x72,
+	0x74, 0x65, 0x63, 0x68, 0x2e, 0x76, 0x31, 0x22, 0x43, 0x0a, 0x05, 0x41, 0x6c, 0x69, 0x61, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x88, 0x01,
+	0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x42, 0x39, 0x5a, 0x37,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x63, 0x68, 0x61, 0x75,
+	0x68, 0x61, 0x6e, 0x39, 0x2f, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x63, 0x68, 0x2f, 0x70, 0x62,
+	0x2f, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x63, 0x68, 0x2f, 0x76, 0x31, 0x3b, 0x73, 0x70, 0x6f,
+	0x72, 0x74, 0x65, 0x63, 0x68, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_sportech_v1_common_proto_rawDescOnce sync.Once
+	file_sportech_v1_common_proto_rawDescData = file_sportech_v1_common_proto_rawDesc
+)
+
+func file_sportech_v1_common_proto_rawDescGZIP() []byte {
+	file_sportech_v1_common_proto_rawDescOnce.Do(func() {
+		file_sportech_v1_common_proto_rawDescData = protoimpl.X.CompressGZIP(file_sportech_v1_common_proto_rawDescData)
+	})
+	return file_sportech_v1_common_proto_rawDescData
+}
+
+var file_sportech_v1_common_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_sportech_v1_common_proto_goTypes = []interface{}{
+	(*Alias)(nil), // 0: sportech.v1.Alias
+}
+var file_sportech_v1_common_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_sportech_v1_common_proto_init() }
+func file_sportech_v1_common_proto_init() {
+	if File_sportech_v1_common_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_sportech_v1_common_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Alias); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_sportech_v1_common_proto_msgTypes[0].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_sportech_v1_common_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_sportech_v1_common_proto_goTypes,
+		DependencyIndexes: file_sportech_v1_common_proto_depIdxs,
+		MessageInfos:      file_sportech_v1_common_proto_msgTypes,
+	}.Build()
+	File_sportech_v1_common_proto = out.File
+	file_sportech_v1_common_proto_rawDesc = nil
+	file_sportech_v1_common_proto_goTypes = nil
+	file_sportech_v1_common_proto_depIdxs = nil
+}
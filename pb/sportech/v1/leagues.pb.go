@@ -0,0 +1,1112 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1-devel
+// 	protoc        (unknown)
+// source: sportech/v1/leagues.proto
+
+package sportechv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type League struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	NumberOfTeams int32  `protobuf:"varint,3,opt,name=number_of_teams,json=numberOfTeams,proto3" json:"number_of_teams,omitempty"`
+	Country       string `protobuf:"bytes,4,opt,name=country,proto3" json:"country,omitempty"`
+	Tier          *int32 `protobuf:"varint,5,opt,name=tier,proto3,oneof" json:"tier,omitempty"`
+}
+
+func (x *League) Reset() {
+	*x = League{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sportech_v1_leagues_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *League) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*League) ProtoMessage() {}
+
+func (x *League) ProtoReflect() protoreflect.Message {
+	mi := &file_sportech_v1_leagues_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use League.ProtoReflect.Descriptor instead.
+func (*League) Descriptor() ([]byte, []int) {
+	return file_sportech_v1_leagues_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *League) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *League) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *League) GetNumberOfTeams() int32 {
+	if x != nil {
+		return x.NumberOfTeams
+	}
+	return 0
+}
+
+func (x *League) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *League) GetTier() int32 {
+	if x != nil && x.Tier != nil {
+		return *x.Tier
+	}
+	return 0
+}
+
+type Link struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id               string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UpperLeague      string `protobuf:"bytes,2,opt,name=upper_league,json=upperLeague,proto3" json:"upper_league,omitempty"`
+	LowerLeague      string `protobuf:"bytes,3,opt,name=lower_league,json=lowerLeague,proto3" json:"lower_league,omitempty"`
+	PromotionPlaces  int32  `protobuf:"varint,4,opt,name=promotion_places,json=promotionPlaces,proto3" json:"promotion_places,omitempty"`
+	RelegationPlaces int32  `protobuf:"varint,5,opt,name=relegation_places,json=relegationPlaces,proto3" json:"relegation_places,omitempty"`
+	PlayoffPlaces    int32  `protobuf:"varint,6,opt,name=playoff_places,json=playoffPlaces,proto3" json:"playoff_places,omitempty"`
+}
+
+func (x *Link) Reset() {
+	*x = Link{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sportech_v1_leagues_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Link) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Link) ProtoMessage() {}
+
+func (x *Link) ProtoReflect() protoreflect.Message {
+	mi := &file_sportech_v1_leagues_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Link.ProtoReflect.Descriptor instead.
+func (*Link) Descriptor() ([]byte, []int) {
+	return file_sportech_v1_leagues_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Link) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Link) GetUpperLeague() string {
+	if x != nil {
+		return x.UpperLeague
+	}
+	return ""
+}
+
+func (x *Link) GetLowerLeague() string {
+	if x != nil {
+		return x.LowerLeague
+	}
+	return ""
+}
+
+func (x *Link) GetPromotionPlaces() int32 {
+	if x != nil {
+		return x.PromotionPlaces
+	}
+	return 0
+}
+
+func (x *Link) GetRelegationPlaces() int32 {
+	if x != nil {
+		return x.RelegationPlaces
+	}
+	return 0
+}
+
+func (x *Link) GetPlayoffPlaces() int32 {
+	if x != nil {
+		return x.PlayoffPlaces
+	}
+	return 0
+}
+
+type Tier struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Level   int32     `protobuf:"varint,1,opt,name=level,proto3" json:"level,omitempty"`
+	Leagues []*League `protobuf:"bytes,2,rep,name=leagues,proto3" json:"leagues,omitempty"`
+}
+
+func (x *Tier) Reset() {
+	*x = Tier{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sportech_v1_leagues_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Tier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tier) ProtoMessage() {}
+
+func (x *Tier) ProtoReflect() protoreflect.Message {
+	mi := &file_sportech_v1_leagues_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tier.ProtoReflect.Descriptor instead.
+func (*Tier) Descriptor() ([]byte, []int) {
+	return file_sportech_v1_leagues_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Tier) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *Tier) GetLeagues() []*League {
+	if x != nil {
+		return x.Leagues
+	}
+	return nil
+}
+
+type Pyramid struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Country string  `protobuf:"bytes,1,opt,name=country,proto3" json:"country,omitempty"`
+	Tiers   []*Tier `protobuf:"bytes,2,rep,name=tiers,proto3" json:"tiers,omitempty"`
+	Links   []*Link `protobuf:"bytes,3,rep,name=links,proto3" json:"links,omitempty"`
+}
+
+func (x *Pyramid) Reset() {
+	*x = Pyramid{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sportech_v1_leagues_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pyramid) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pyramid) ProtoMessage() {}
+
+func (x *Pyramid) ProtoReflect() protoreflect.Message {
+	mi := &file_sportech_v1_leagues_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pyramid.ProtoReflect.Descriptor instead.
+func (*Pyramid) Descriptor() ([]byte, []int) {
+	return file_sportech_v1_leagues_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Pyramid) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *Pyramid) GetTiers() []*Tier {
+	if x != nil {
+		return x.Tiers
+	}
+	return nil
+}
+
+func (x *Pyramid) GetLinks() []*Link {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+type Movement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Team       string `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	FromLeague string `protobuf:"bytes,2,opt,name=from_league,json=fromLeague,proto3" json:"from_league,omitempty"`
+	ToLeague   string `protobuf:"bytes,3,opt,name=to_league,json=toLeague,proto3" json:"to_league,omitempty"`
+	Reason     string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *Movement) Reset() {
+	*x = Movement{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sportech_v1_leagues_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Movement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Movement) ProtoMessage() {}
+
+func (x *Movement) ProtoReflect() protoreflect.Message {
+	mi := &file_sportech_v1_leagues_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Movement.ProtoReflect.Descriptor instead.
+func (*Movement) Descriptor() ([]byte, []int) {
+	return file_sportech_v1_leagues_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Movement) GetTeam() string {
+	if x != nil {
+		return x.Team
+	}
+	return ""
+}
+
+func (x *Movement) GetFromLeague() string {
+	if x != nil {
+		return x.FromLeague
+	}
+	return ""
+}
+
+func (x *Movement) GetToLeague() string {
+	if x != nil {
+		return x.ToLeague
+	}
+	return ""
+}
+
+func (x *Movement) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ListLeaguesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListLeaguesRequest) Reset() {
+	*x = ListLeaguesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sportech_v1_leagues_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListLeaguesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLeaguesRequest) ProtoMessage() {}
+
+func (x *ListLeaguesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sportech_v1_leagues_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLeaguesRequest.ProtoReflect.Descriptor instead.
+func (*ListLeaguesRequest) Descriptor() ([]byte, []int) {
+	return file_sportech_v1_leagues_proto_rawDescGZIP(), []int{5}
+}
+
+type ListLeaguesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Leagues []*League `protobuf:"bytes,1,rep,name=leagues,proto3" json:"leagues,omitempty"`
+}
+
+func (x *ListLeaguesResponse) Reset() {
+	*x = ListLeaguesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sportech_v1_leagues_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListLeaguesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLeaguesResponse) ProtoMessage() {}
+
+func (x *ListLeaguesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sportech_v1_leagues_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLeaguesResponse.ProtoReflect.Descriptor instead.
+func (*ListLeaguesResponse) Descriptor() ([]byte, []int) {
+	return file_sportech_v1_leagues_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListLeaguesResponse) GetLeagues() []*League {
+	if x != nil {
+		return x.Leagues
+	}
+	return nil
+}
+
+type GetLeagueRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetLeagueRequest) Reset() {
+	*x = GetLeagueRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sportech_v1_leagues_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLeagueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLeagueRequest) ProtoMessage() {}
+
+func (x *GetLeagueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sportech_v1_leagues_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLeagueRequest.ProtoReflect.Descriptor instead.
+func (*GetLeagueRequest) Descriptor() ([]byte, []int) {
+	return file_sportech_v1_leagues_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetLeagueRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetLeagueResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	League *League `protobuf:"bytes,1,opt,name=league,proto3" json:"league,omitempty"`
+}
+
+func (x *GetLeagueResponse) Reset() {
+	*x = GetLeagueResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sportech_v1_leagues_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLeagueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLeagueResponse) ProtoMessage() {}
+
+func (x *GetLeagueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sportech_v1_leagues_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLeagueResponse.ProtoReflect.Descriptor instead.
+func (*GetLeagueResponse) Descriptor() ([]byte, []int) {
+	return file_sportech_v1_leagues_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetLeagueResponse) GetLeague() *League {
+	if x != nil {
+		return x.League
+	}
+	return nil
+}
+
+type GetPyramidRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CountryId string `protobuf:"bytes,1,opt,name=country_id,json=countryId,proto3" json:"country_id,omitempty"`
+}
+
+func (x *GetPyramidRequest) Reset() {
+	*x = GetPyramidRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sportech_v1_leagues_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPyramidRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPyramidRequest) ProtoMessage() {}
+
+func (x *GetPyramidRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sportech_v1_leagues_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPyramidRequest.ProtoReflect.Descriptor instead.
+func (*GetPyramidRequest) Descriptor() ([]byte, []int) {
+	return file_sportech_v1_leagues_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetPyramidRequest) GetCountryId() string {
+	if x != nil {
+		return x.CountryId
+	}
+	return ""
+}
+
+type GetPyramidResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pyramid *Pyramid `protobuf:"bytes,1,opt,name=pyramid,proto3" json:"pyramid,omitempty"`
+}
+
+func (x *GetPyramidResponse) Reset() {
+	*x = GetPyramidResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sportech_v1_leagues_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPyramidResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPyramidResponse) ProtoMessage() {}
+
+func (x *GetPyramidResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sportech_v1_leagues_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPyramidResponse.ProtoReflect.Descriptor instead.
+func (*GetPyramidResponse) Descriptor() ([]byte, []int) {
+	return file_sportech_v1_leagues_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetPyramidResponse) GetPyramid() *Pyramid {
+	if x != nil {
+		return x.Pyramid
+	}
+	return nil
+}
+
+// PlayoffWinners maps each league with playoff places to the team that won its playoff.
+type EndSeasonRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CountryId      string            `protobuf:"bytes,1,opt,name=country_id,json=countryId,proto3" json:"country_id,omitempty"`
+	Season         int32             `protobuf:"varint,2,opt,name=season,proto3" json:"season,omitempty"`
+	PlayoffWinners map[string]string `protobuf:"bytes,3,rep,name=playoff_winners,json=playoffWinners,proto3" json:"playoff_winners,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *EndSeasonRequest) Reset() {
+	*x = EndSeasonRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sportech_v1_leagues_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EndSeasonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndSeasonRequest) ProtoMessage() {}
+
+func (x *EndSeasonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sportech_v1_leagues_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndSeasonRequest.ProtoReflect.Descriptor instead.
+func (*EndSeasonRequest) Descriptor() ([]byte, []int) {
+	return file_sportech_v1_leagues_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *EndSeasonRequest) GetCountryId() string {
+	if x != nil {
+		return x.CountryId
+	}
+	return ""
+}
+
+func (x *EndSeasonRequest) GetSeason() int32 {
+	if x != nil {
+		return x.Season
+	}
+	return 0
+}
+
+func (x *EndSeasonRequest) GetPlayoffWinners() map[string]string {
+	if x != nil {
+		return x.PlayoffWinners
+	}
+	return nil
+}
+
+type EndSeasonResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Movements []*Movement `protobuf:"bytes,1,rep,name=movements,proto3" json:"movements,omitempty"`
+}
+
+func (x *EndSeasonResponse) Reset() {
+	*x = EndSeasonResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sportech_v1_leagues_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EndSeasonResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndSeasonResponse) ProtoMessage() {}
+
+func (x *EndSeasonResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sportech_v1_leagues_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndSeasonResponse.ProtoReflect.Descriptor instead.
+func (*EndSeasonResponse) Descriptor() ([]byte, []int) {
+	return file_sportech_v1_leagues_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *EndSeasonResponse) GetMovements() []*Movement {
+	if x != nil {
+		return x.Movements
+	}
+	return nil
+}
+
+var File_sportech_v1_leagues_proto protoreflect.FileDescriptor
+
+var file_sportech_v1_leagues_proto_rawDesc = []byte{
+	0x0a, 0x19, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x63, 0x68, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x65,
+	0x61, 0x67, 0x75, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x73, 0x70, 0x6f,
+	0x72, 0x74, 0x65, 0x63, 0x68, 0x2e, 0x76, 0x31, 0x22, 0x90, 0x01, 0x0a, 0x06, 0x4c, 0x65, 0x61,
+	0x67, 0x75, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x5f, 0x6f, 0x66, 0x5f, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0d, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66, 0x54, 0x65, 0x61, 0x6d, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x17, 0x0a, 0x04, 0x74, 0x69, 0x65,
+	0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x04, 0x74, 0x69, 0x65, 0x72, 0x88,
+	0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x74, 0x69, 0x65, 0x72, 0x22, 0xdb, 0x01, 0x0a, 0x04,
+	0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x70, 0x70, 0x65, 0x72, 0x5f, 0x6c, 0x65,
+	0x61, 0x67, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x75, 0x70, 0x70, 0x65,
+	0x72, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x6f, 0x77, 0x65, 0x72,
+	0x5f, 0x6c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6c,
+	0x6f, 0x77, 0x65, 0x72, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x72,
+	0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x6c, 0x61, 0x63, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x10, 0x72, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6c, 0x61, 0x63,
+	0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x6c, 0x61, 0x79, 0x6f, 0x66, 0x66, 0x5f, 0x70, 0x6c,
+	0x61, 0x63, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x70, 0x6c, 0x61, 0x79,
+	0x6f, 0x66, 0x66, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x22, 0x4b, 0x0a, 0x04, 0x54, 0x69, 0x65,
+	0x72, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x2d, 0x0a, 0x07, 0x6c, 0x65, 0x61, 0x67, 0x75,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74,
+	0x65, 0x63, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x52, 0x07, 0x6c,
+	0x65, 0x61, 0x67, 0x75, 0x65, 0x73, 0x22, 0x75, 0x0a, 0x07, 0x50, 0x79, 0x72, 0x61, 0x6d, 0x69,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x27, 0x0a, 0x05, 0x74,
+	0x69, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x70, 0x6f,
+	0x72, 0x74, 0x65, 0x63, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x65, 0x72, 0x52, 0x05, 0x74,
+	0x69, 0x65, 0x72, 0x73, 0x12, 0x27, 0x0a, 0x05, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x63, 0x68, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x05, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x22, 0x74, 0x0a,
+	0x08, 0x4d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x61,
+	0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x61, 0x6d, 0x12, 0x1f, 0x0a,
+	0x0b, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x6c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x74, 0x6f, 0x5f, 0x6c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x74, 0x6f, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x22, 0x14, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x65, 0x61, 0x67, 0x75,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x44, 0x0a, 0x13, 0x4c, 0x69, 0x73,
+	0x74, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2d, 0x0a, 0x07, 0x6c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x63, 0x68, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x52, 0x07, 0x6c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x73, 0x22,
+	0x22, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0x40, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x06, 0x6c, 0x65, 0x61, 0x67,
+	0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74,
+	0x65, 0x63, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x52, 0x06, 0x6c,
+	0x65, 0x61, 0x67, 0x75, 0x65, 0x22, 0x32, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x79, 0x72, 0x61,
+	0x6d, 0x69, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x49, 0x64, 0x22, 0x44, 0x0a, 0x12, 0x47, 0x65, 0x74,
+	0x50, 0x79, 0x72, 0x61, 0x6d, 0x69, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2e, 0x0a, 0x07, 0x70, 0x79, 0x72, 0x61, 0x6d, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x63, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x79, 0x72, 0x61, 0x6d, 0x69, 0x64, 0x52, 0x07, 0x70, 0x79, 0x72, 0x61, 0x6d, 0x69, 0x64, 0x22,
+	0xe8, 0x01, 0x0a, 0x10, 0x45, 0x6e, 0x64, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72,
+	0x79, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x5a, 0x0a, 0x0f, 0x70,
+	0x6c, 0x61, 0x79, 0x6f, 0x66, 0x66, 0x5f, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x63, 0x68, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x6e, 0x64, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x6f, 0x66, 0x66, 0x57, 0x69, 0x6e, 0x6e, 0x65,
+	0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x70, 0x6c, 0x61, 0x79, 0x6f, 0x66, 0x66,
+	0x57, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x73, 0x1a, 0x41, 0x0a, 0x13, 0x50, 0x6c, 0x61, 0x79, 0x6f,
+	0x66, 0x66, 0x57, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x48, 0x0a, 0x11, 0x45, 0x6e,
+	0x64, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x33, 0x0a, 0x09, 0x6d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x63, 0x68, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x09, 0x6d, 0x6f, 0x76, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x32, 0xc8, 0x02, 0x0a, 0x0d, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x50, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x65,
+	0x61, 0x67, 0x75, 0x65, 0x73, 0x12, 0x1f, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x63, 0x68,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x63,
+	0x68, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x4c,
+	0x65, 0x61, 0x67, 0x75, 0x65, 0x12, 0x1d, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x63, 0x68,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x63, 0x68, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x50, 0x79, 0x72, 0x61, 0x6d,
+	0x69, 0x64, 0x12, 0x1e, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x63, 0x68, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x50, 0x79, 0x72, 0x61, 0x6d, 0x69, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x63, 0x68, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x50, 0x79, 0x72, 0x61, 0x6d, 0x69, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x09, 0x45, 0x6e, 0x64, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x12, 0x1d, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x63, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x45,
+	0x6e, 0x64, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1e, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x63, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e,
+	0x64, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42,
+	0x39, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x63,
+	0x68, 0x61, 0x75, 0x68, 0x61, 0x6e, 0x39, 0x2f, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x63, 0x68,
+	0x2f, 0x70, 0x62, 0x2f, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x63, 0x68, 0x2f, 0x76, 0x31, 0x3b,
+	0x73, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x63, 0x68, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_sportech_v1_leagues_proto_rawDescOnce sync.Once
+	file_sportech_v1_leagues_proto_rawDescData = file_sportech_v1_leagues_proto_rawDesc
+)
+
+func file_sportech_v1_leagues_proto_rawDescGZIP() []byte {
+	file_sportech_v1_leagues_proto_rawDescOnce.Do(func() {
+		file_sportech_v1_leagues_proto_rawDescData = protoimpl.X.CompressGZIP(file_sportech_v1_leagues_proto_rawDescData)
+	})
+	return file_sportech_v1_leagues_proto_rawDescData
+}
+
+var file_sportech_v1_leagues_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_sportech_v1_leagues_proto_goTypes = []interface{}{
+	(*League)(nil),              // 0: sportech.v1.League
+	(*Link)(nil),                // 1: sportech.v1.Link
+	(*Tier)(nil),                // 2: sportech.v1.Tier
+	(*Pyramid)(nil),             // 3: sportech.v1.Pyramid
+	(*Movement)(nil),            // 4: sportech.v1.Movement
+	(*ListLeaguesRequest)(nil),  // 5: sportech.v1.ListLeaguesRequest
+	(*ListLeaguesResponse)(nil), // 6: sportech.v1.ListLeaguesResponse
+	(*GetLeagueRequest)(nil),    // 7: sportech.v1.GetLeagueRequest
+	(*GetLeagueResponse)(nil),   // 8: sportech.v1.GetLeagueResponse
+	(*GetPyramidRequest)(nil),   // 9: sportech.v1.GetPyramidRequest
+	(*GetPyramidResponse)(nil),  // 10: sportech.v1.GetPyramidResponse
+	(*EndSeasonRequest)(nil),    // 11: sportech.v1.EndSeasonRequest
+	(*EndSeasonResponse)(nil),   // 12: sportech.v1.EndSeasonResponse
+	nil,                         // 13: sportech.v1.EndSeasonRequest.PlayoffWinnersEntry
+}
+var file_sportech_v1_leagues_proto_depIdxs = []int32{
+	0,  // 0: sportech.v1.Tier.leagues:type_name -> sportech.v1.League
+	2,  // 1: sportech.v1.Pyramid.tiers:type_name -> sportech.v1.Tier
+	1,  // 2: sportech.v1.Pyramid.links:type_name -> sportech.v1.Link
+	0,  // 3: sportech.v1.ListLeaguesResponse.leagues:type_name -> sportech.v1.League
+	0,  // 4: sportech.v1.GetLeagueResponse.league:type_name -> sportech.v1.League
+	3,  // 5: sportech.v1.GetPyramidResponse.pyramid:type_name -> sportech.v1.Pyramid
+	13, // 6: sportech.v1.EndSeasonRequest.playoff_winners:type_name -> sportech.v1.EndSeasonRequest.PlayoffWinnersEntry
+	4,  // 7: sportech.v1.EndSeasonResponse.movements:type_name -> sportech.v1.Movement
+	5,  // 8: sportech.v1.LeagueService.ListLeagues:input_type -> sportech.v1.ListLeaguesRequest
+	7,  // 9: sportech.v1.LeagueService.GetLeague:input_type -> sportech.v1.GetLeagueRequest
+	9,  // 10: sportech.v1.LeagueService.GetPyramid:input_type -> sportech.v1.GetPyramidRequest
+	11, // 11: sportech.v1.LeagueService.EndSeason:input_type -> sportech.v1.EndSeasonRequest
+	6,  // 12: sportech.v1.LeagueService.ListLeagues:output_type -> sportech.v1.ListLeaguesResponse
+	8,  // 13: sportech.v1.LeagueService.GetLeague:output_type -> sportech.v1.GetLeagueResponse
+	10, // 14: sportech.v1.LeagueService.GetPyramid:output_type -> sportech.v1.GetPyramidResponse
+	12, // 15: sportech.v1.LeagueService.EndSeason:output_type -> sportech.v1.EndSeasonResponse
+	12, // [12:16] is the sub-list for method output_type
+	8,  // [8:12] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_sportech_v1_leagues_proto_init() }
+func file_sportech_v1_leagues_proto_init() {
+	if File_sportech_v1_leagues_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_sportech_v1_leagues_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*League); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sportech_v1_leagues_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Link); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sportech_v1_leagues_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Tier); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sportech_v1_leagues_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pyramid); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sportech_v1_leagues_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Movement); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sportech_v1_leagues_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListLeaguesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sportech_v1_leagues_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListLeaguesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sportech_v1_leagues_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLeagueRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sportech_v1_leagues_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLeagueResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sportech_v1_leagues_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPyramidRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sportech_v1_leagues_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPyramidResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sportech_v1_leagues_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EndSeasonRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sportech_v1_leagues_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EndSeasonResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_sportech_v1_leagues_proto_msgTypes[0].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_sportech_v1_leagues_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   14,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_sportech_v1_leagues_proto_goTypes,
+		DependencyIndexes: file_sportech_v1_leagues_proto_depIdxs,
+		MessageInfos:      file_sportech_v1_leagues_proto_msgTypes,
+	}.Build()
+	File_sportech_v1_leagues_proto = out.File
+	file_sportech_v1_leagues_proto_rawDesc = nil
+	file_sportech_v1_leagues_proto_goTypes = nil
+	file_sportech_v1_leagues_proto_depIdxs = nil
+}
@@ -0,0 +1,213 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             (unknown)
+// source: sportech/v1/leagues.proto
+
+package sportechv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// LeagueServiceClient is the client API for LeagueService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type LeagueServiceClient interface {
+	ListLeagues(ctx context.Context, in *ListLeaguesRequest, opts ...grpc.CallOption) (*ListLeaguesResponse, error)
+	GetLeague(ctx context.Context, in *GetLeagueRequest, opts ...grpc.CallOption) (*GetLeagueResponse, error)
+	GetPyramid(ctx context.Context, in *GetPyramidRequest, opts ...grpc.CallOption) (*GetPyramidResponse, error)
+	EndSeason(ctx context.Context, in *EndSeasonRequest, opts ...grpc.CallOption) (*EndSeasonResponse, error)
+}
+
+type leagueServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewLeagueServiceClient(cc grpc.ClientConnInterface) LeagueServiceClient {
+	return &leagueServiceClient{cc}
+}
+
+func (c *leagueServiceClient) ListLeagues(ctx context.Context, in *ListLeaguesRequest, opts ...grpc.CallOption) (*ListLeaguesResponse, error) {
+	out := new(ListLeaguesResponse)
+	err := c.cc.Invoke(ctx, "/sportech.v1.LeagueService/ListLeagues", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leagueServiceClient) GetLeague(ctx context.Context, in *GetLeagueRequest, opts ...grpc.CallOption) (*GetLeagueResponse, error) {
+	out := new(GetLeagueResponse)
+	err := c.cc.Invoke(ctx, "/sportech.v1.LeagueService/GetLeague", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leagueServiceClient) GetPyramid(ctx context.Context, in *GetPyramidRequest, opts ...grpc.CallOption) (*GetPyramidResponse, error) {
+	out := new(GetPyramidResponse)
+	err := c.cc.Invoke(ctx, "/sportech.v1.LeagueService/GetPyramid", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leagueServiceClient) EndSeason(ctx context.Context, in *EndSeasonRequest, opts ...grpc.CallOption) (*EndSeasonResponse, error) {
+	out := new(EndSeasonResponse)
+	err := c.cc.Invoke(ctx, "/sportech.v1.LeagueService/EndSeason", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LeagueServiceServer is the server API for LeagueService service.
+// All implementations must embed UnimplementedLeagueServiceServer
+// for forward compatibility
+type LeagueServiceServer interface {
+	ListLeagues(context.Context, *ListLeaguesRequest) (*ListLeaguesResponse, error)
+	GetLeague(context.Context, *GetLeagueRequest) (*GetLeagueResponse, error)
+	GetPyramid(context.Context, *GetPyramidRequest) (*GetPyramidResponse, error)
+	EndSeason(context.Context, *EndSeasonRequest) (*EndSeasonResponse, error)
+	mustEmbedUnimplementedLeagueServiceServer()
+}
+
+// UnimplementedLeagueServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedLeagueServiceServer struct {
+}
+
+func (UnimplementedLeagueServiceServer) ListLeagues(context.Context, *ListLeaguesRequest) (*ListLeaguesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLeagues not implemented")
+}
+func (UnimplementedLeagueServiceServer) GetLeague(context.Context, *GetLeagueRequest) (*GetLeagueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLeague not implemented")
+}
+func (UnimplementedLeagueServiceServer) GetPyramid(context.Context, *GetPyramidRequest) (*GetPyramidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPyramid not implemented")
+}
+func (UnimplementedLeagueServiceServer) EndSeason(context.Context, *EndSeasonRequest) (*EndSeasonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EndSeason not implemented")
+}
+func (UnimplementedLeagueServiceServer) mustEmbedUnimplementedLeagueServiceServer() {}
+
+// UnsafeLeagueServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LeagueServiceServer will
+// result in compilation errors.
+type UnsafeLeagueServiceServer interface {
+	mustEmbedUnimplementedLeagueServiceServer()
+}
+
+func RegisterLeagueServiceServer(s grpc.ServiceRegistrar, srv LeagueServiceServer) {
+	s.RegisterService(&LeagueService_ServiceDesc, srv)
+}
+
+func _LeagueService_ListLeagues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLeaguesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeagueServiceServer).ListLeagues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sportech.v1.LeagueService/ListLeagues",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeagueServiceServer).ListLeagues(ctx, req.(*ListLeaguesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeagueService_GetLeague_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLeagueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeagueServiceServer).GetLeague(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sportech.v1.LeagueService/GetLeague",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeagueServiceServer).GetLeague(ctx, req.(*GetLeagueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeagueService_GetPyramid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPyramidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeagueServiceServer).GetPyramid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sportech.v1.LeagueService/GetPyramid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeagueServiceServer).GetPyramid(ctx, req.(*GetPyramidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeagueService_EndSeason_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EndSeasonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeagueServiceServer).EndSeason(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sportech.v1.LeagueService/EndSeason",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeagueServiceServer).EndSeason(ctx, req.(*EndSeasonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// LeagueService_ServiceDesc is the grpc.ServiceDesc for LeagueService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var LeagueService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "sportech.v1.LeagueService",
+	HandlerType: (*LeagueServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListLeagues",
+			Handler:    _LeagueService_ListLeagues_Handler,
+		},
+		{
+			MethodName: "GetLeague",
+			Handler:    _LeagueService_GetLeague_Handler,
+		},
+		{
+			MethodName: "GetPyramid",
+			Handler:    _LeagueService_GetPyramid_Handler,
+		},
+		{
+			MethodName: "EndSeason",
+			Handler:    _LeagueService_EndSeason_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "sportech/v1/leagues.proto",
+}
@@ -0,0 +1,1063 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1-devel
+// 	protoc        (unknown)
+// source: sportech/v1/referees.proto
+
+package sportechv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Referee struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FirstName   string                 `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	MiddleNames *string                `protobuf:"bytes,3,opt,name=middle_names,json=middleNames,proto3,oneof" json:"middle_names,omitempty"`
+	LastName    string                 `protobuf:"bytes,4,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	KnownAs     *string                `protobuf:"bytes,5,opt,name=known_as,json=knownAs,proto3,oneof" json:"known_as,omitempty"`
+	DateOfBirth *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=date_of_birth,json=dateOfBirth,proto3" json:"date_of_birth,omitempty"`
+	Nationality string                 `protobuf:"bytes,7,opt,name=nationality,proto3" json:"nationality,omitempty"`
+	Aliases     []*Alias               `protobuf:"bytes,8,rep,name=aliases,proto3" json:"aliases,omitempty"`
+	Started     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=started,proto3" json:"started,omitempty"`
+	Ended       *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=ended,proto3" json:"ended,omitempty"`
+}
+
+func (x *Referee) Reset() {
+	*x = Referee{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sportech_v1_referees_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Referee) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Referee) ProtoMessage() {}
+
+func (x *Referee) ProtoReflect() protoreflect.Message {
+	mi := &file_sportech_v1_referees_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Referee.ProtoReflect.Descriptor instead.
+func (*Referee) Descriptor() ([]byte, []int) {
+	return file_sportech_v1_referees_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Referee) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Referee) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *Referee) GetMiddleNames() string {
+	if x != nil && x.MiddleNames != nil {
+		return *x.MiddleNames
+	}
+	return ""
+}
+
+func (x *Referee) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *Referee) GetKnownAs() string {
+	if x != nil && x.KnownAs != nil {
+		return *x.KnownAs
+	}
+	return ""
+}
+
+func (x *Referee) GetDateOfBirth() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateOfBirth
+	}
+	return nil
+}
+
+func (x *Referee) GetNationality() string {
+	if x != nil {
+		return x.Nationality
+	}
+	return ""
+}
+
+func (x *Referee) GetAliases() []*Alias {
+	if x != nil {
+		return x.Aliases
+	}
+	return nil
+}
+
+func (x *Referee) GetStarted() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Started
+	}
+	return nil
+}
+
+func (x *Referee) GetEnded() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Ended
+	}
+	return nil
+}
+
+type MatchAssignment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MatchId  string                 `protobuf:"bytes,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	Role     string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	HomeTeam string                 `protobuf:"bytes,3,opt,name=home_team,json=homeTeam,proto3" json:"home_team,omitempty"`
+	AwayTeam string                 `protobuf:"bytes,4,opt,name=away_team,json=awayTeam,proto3" json:"away_team,omitempty"`
+	League   string                 `protobuf:"bytes,5,opt,name=league,proto3" json:"league,omitempty"`
+	Stadium  string                 `protobuf:"bytes,6,opt,name=stadium,proto3" json:"stadium,omitempty"`
+	Kickoff  *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=kickoff,proto3" json:"kickoff,omitempty"`
+}
+
+func (x *MatchAssignment) Reset() {
+	*x = MatchAssignment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sportech_v1_referees_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MatchAssignment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchAssignment) ProtoMessage() {}
+
+func (x *MatchAssignment) ProtoReflect() protoreflect.Message {
+	mi := &file_sportech_v1_referees_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchAssignment.ProtoReflect.Descriptor instead.
+func (*MatchAssignment) Descriptor() ([]byte, []int) {
+	return file_sportech_v1_referees_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MatchAssignment) GetMatchId() string {
+	if x != nil {
+		return x.MatchId
+	}
+	return ""
+}
+
+func (x *MatchAssignment) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *MatchAssignment) GetHomeTeam() string {
+	if x != nil {
+		return x.HomeTeam
+	}
+	return ""
+}
+
+func (x *MatchAssignment) GetAwayTeam() string {
+	if x != nil {
+		return x.AwayTeam
+	}
+	return ""
+}
+
+func (x *MatchAssignment) GetLeague() string {
+	if x != nil {
+		return x.League
+	}
+	return ""
+}
+
+func (x *MatchAssignment) GetStadium() string {
+	if x != nil {
+		return x.Stadium
+	}
+	return ""
+}
+
+func (x *MatchAssignment) GetKickoff() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Kickoff
+	}
+	return nil
+}
+
+// Only counts matches where the official was the referee, as the other roles don't hand out
+// cards or award penalties.
+type DisciplineStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Referee                 string  `protobuf:"bytes,1,opt,name=referee,proto3" json:"referee,omitempty"`
+	Matches                 int32   `protobuf:"varint,2,opt,name=matches,proto3" json:"matches,omitempty"`
+	YellowCards             int32   `protobuf:"varint,3,opt,name=yellow_cards,json=yellowCards,proto3" json:"yellow_cards,omitempty"`
+	RedCards                int32   `protobuf:"varint,4,opt,name=red_cards,json=redCards,proto3" json:"red_cards,omitempty"`
+	PenaltiesAwarded        int32   `protobuf:"varint,5,opt,name=penalties_awarded,json=penaltiesAwarded,proto3" json:"penalties_awarded,omitempty"`
+	YellowCardsPerGame      float64 `protobuf:"fixed64,6,opt,name=yellow_cards_per_game,json=yellowCardsPerGame,proto3" json:"yellow_cards_per_game,omitempty"`
+	RedCardsPerGame         float64 `protobuf:"fixed64,7,opt,name=red_cards_per_game,json=redCardsPerGame,proto3" json:"red_cards_per_game,omitempty"`
+	CardsPerGame            float64 `protobuf:"fixed64,8,opt,name=cards_per_game,json=cardsPerGame,proto3" json:"cards_per_game,omitempty"`
+	PenaltiesAwardedPerGame float64 `protobuf:"fixed64,9,opt,name=penalties_awarded_per_game,json=penaltiesAwardedPerGame,proto3" json:"penalties_awarded_per_game,omitempty"`
+}
+
+func (x *DisciplineStats) Reset() {
+	*x = DisciplineStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sportech_v1_referees_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DisciplineStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisciplineStats) ProtoMessage() {}
+
+func (x *DisciplineStats) ProtoReflect() protoreflect.Message {
+	mi := &file_sportech_v1_referees_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisciplineStats.ProtoReflect.Descriptor instead.
+func (*DisciplineStats) Descriptor() ([]byte, []int) {
+	return file_sportech_v1_referees_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DisciplineStats) GetReferee() string {
+	if x != nil {
+		return x.Referee
+	}
+	return ""
+}
+
+func (x *DisciplineStats) GetMatches() int32 {
+	if x != nil {
+		return x.Matches
+	}
+	return 0
+}
+
+func (x *DisciplineStats) GetYellowCards() int32 {
+	if x != nil {
+		return x.YellowCards
+	}
+	return 0
+}
+
+func (x *DisciplineStats) GetRedCards() int32 {
+	if x != nil {
+		return x.RedCards
+	}
+	return 0
+}
+
+func (x *DisciplineStats) GetPenaltiesAwarded() int32 {
+	if x != nil {
+		return x.PenaltiesAwarded
+	}
+	return 0
+}
+
+func (x *DisciplineStats) GetYellowCardsPerGame() float64 {
+	if x != nil {
+		return x.YellowCardsPerGame
+	}
+	return 0
+}
+
+func (x *DisciplineStats) GetRedCardsPerGame() float64 {
+	if x != nil {
+		return x.RedCardsPerGame
+	}
+	return 0
+}
+
+func (x *DisciplineStats) GetCardsPerGame() float64 {
+	if x != nil {
+		return x.CardsPerGame
+	}
+	return 0
+}
+
+func (x *DisciplineStats) GetPenaltiesAwardedPerGame() float64 {
+	if x != nil {
+		return x.PenaltiesAwardedPerGame
+	}
+	return 0
+}
+
+type ListRefereesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListRefereesRequest) Reset() {
+	*x = ListRefereesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sportech_v1_referees_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRefereesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRefereesRequest) ProtoMessage() {}
+
+func (x *ListRefereesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sportech_v1_referees_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRefereesRequest.ProtoReflect.Descriptor instead.
+func (*ListRefereesRequest) Descriptor() ([]byte, []int) {
+	return file_sportech_v1_referees_proto_rawDescGZIP(), []int{3}
+}
+
+type ListRefereesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Referees []*Referee `protobuf:"bytes,1,rep,name=referees,proto3" json:"referees,omitempty"`
+}
+
+func (x *ListRefereesResponse) Reset() {
+	*x = ListRefereesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sportech_v1_referees_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRefereesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRefereesResponse) ProtoMessage() {}
+
+func (x *ListRefereesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sportech_v1_referees_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRefereesResponse.ProtoReflect.Descriptor instead.
+func (*ListRefereesResponse) Descriptor() ([]byte, []int) {
+	return file_sportech_v1_referees_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListRefereesResponse) GetReferees() []*Referee {
+	if x != nil {
+		return x.Referees
+	}
+	return nil
+}
+
+type GetRefereeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetRefereeRequest) Reset() {
+	*x = GetRefereeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sportech_v1_referees_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRefereeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRefereeRequest) ProtoMessage() {}
+
+func (x *GetRefereeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sportech_v1_referees_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRefereeRequest.ProtoReflect.Descriptor instead.
+func (*GetRefereeRequest) Descriptor() ([]byte, []int) {
+	return file_sportech_v1_referees_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetRefereeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetRefereeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Referee *Referee `protobuf:"bytes,1,opt,name=referee,proto3" json:"referee,omitempty"`
+}
+
+func (x *GetRefereeResponse) Reset() {
+	*x = GetRefereeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sportech_v1_referees_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRefereeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRefereeResponse) ProtoMessage() {}
+
+func (x *GetRefereeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sportech_v1_referees_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRefereeResponse.ProtoReflect.Descriptor instead.
+func (*GetRefereeResponse) Descriptor() ([]byte, []int) {
+	return file_sportech_v1_referees_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetRefereeResponse) GetReferee() *Referee {
+	if x != nil {
+		return x.Referee
+	}
+	return nil
+}
+
+type ListRefereeMatchesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ListRefereeMatchesRequest) Reset() {
+	*x = ListRefereeMatchesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sportech_v1_referees_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRefereeMatchesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRefereeMatchesRequest) ProtoMessage() {}
+
+func (x *ListRefereeMatchesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sportech_v1_referees_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRefereeMatchesRequest.ProtoReflect.Descriptor instead.
+func (*ListRefereeMatchesRequest) Descriptor() ([]byte, []int) {
+	return file_sportech_v1_referees_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListRefereeMatchesRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListRefereeMatchesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Matches []*MatchAssignment `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"`
+}
+
+func (x *ListRefereeMatchesResponse) Reset() {
+	*x = ListRefereeMatchesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sportech_v1_referees_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRefereeMatchesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRefereeMatchesResponse) ProtoMessage() {}
+
+func (x *ListRefereeMatchesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sportech_v1_referees_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRefereeMatchesResponse.ProtoReflect.Descriptor instead.
+func (*ListRefereeMatchesResponse) Descriptor() ([]byte, []int) {
+	return file_sportech_v1_referees_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListRefereeMatchesResponse) GetMatches() []*MatchAssignment {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+type GetRefereeStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetRefereeStatsRequest) Reset() {
+	*x = GetRefereeStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sportech_v1_referees_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRefereeStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRefereeStatsRequest) ProtoMessage() {}
+
+func (x *GetRefereeStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sportech_v1_referees_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRefereeStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetRefereeStatsRequest) Descriptor() ([]byte, []int) {
+	return file_sportech_v1_referees_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetRefereeStatsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetRefereeStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Stats *DisciplineStats `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats,omitempty"`
+}
+
+func (x *GetRefereeStatsResponse) Reset() {
+	*x = GetRefereeStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sportech_v1_referees_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRefereeStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRefereeStatsResponse) ProtoMessage() {}
+
+func (x *GetRefereeStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sportech_v1_referees_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRefereeStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetRefereeStatsResponse) Descriptor() ([]byte, []int) {
+	return file_sportech_v1_referees_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetRefereeStatsResponse) GetStats() *DisciplineStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+var File_sportech_v1_referees_proto protoreflect.FileDescriptor
+
+var file_sportech_v1_referees_proto_rawDesc = []byte{
+	0x0a, 0x1a, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x63, 0x68, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x73, 0x70,
+	0x6f, 0x72, 0x74, 0x65, 0x63, 0x68, 0x2e, 0x76, 0x31, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x73, 0x70, 0x6f, 0x72,
+	0x74, 0x65, 0x63, 0x68, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb3, 0x03, 0x0a, 0x07, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x65,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x26, 0x0a, 0x0c, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x73, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x08, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x5f, 0x61, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x07, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x41,
+	0x73, 0x88, 0x01, 0x01, 0x12, 0x3e, 0x0a, 0x0d, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6f, 0x66, 0x5f,
+	0x62, 0x69, 0x72, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x42,
+	0x69, 0x72, 0x74, 0x68, 0x12, 0x20, 0x0a, 0x0b, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c,
+	0x69, 0x74, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x2c, 0x0a, 0x07, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x65,
+	0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x65,
+	0x63, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x52, 0x07, 0x61, 0x6c, 0x69,
+	0x61, 0x73, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x12, 0x30, 0x0a, 0x05, 0x65, 0x6e,
+	0x64, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x42, 0x0f, 0x0a, 0x0d,
+	0x5f, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x42, 0x0b, 0x0a,
+	0x09, 0x5f, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x5f, 0x61, 0x73, 0x22, 0xe2, 0x01, 0x0a, 0x0f, 0x4d,
+	0x61, 0x74, 0x63, 0x68, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x19,
+	0x0a, 0x08, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x1b, 0x0a,
+	0x09, 0x68, 0x6f, 0x6d, 0x65, 0x5f, 0x74, 0x65, 0x61, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x68, 0x6f, 0x6d, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x77,
+	0x61, 0x79, 0x5f, 0x74, 0x65, 0x61, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61,
+	0x77, 0x61, 0x79, 0x54, 0x65, 0x61, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x61, 0x67, 0x75,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x64, 0x69, 0x75, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x73, 0x74, 0x61, 0x64, 0x69, 0x75, 0x6d, 0x12, 0x34, 0x0a, 0x07, 0x6b, 0x69, 0x63,
+	0x6b, 0x6f, 0x66, 0x66, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x6b, 0x69, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x22,
+	0xf5, 0x02, 0x0a, 0x0f, 0x44, 0x69, 0x73, 0x63, 0x69, 0x70, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07,
+	0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x79, 0x65, 0x6c, 0x6c, 0x6f,
+	0x77, 0x5f, 0x63, 0x61, 0x72, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x79,
+	0x65, 0x6c, 0x6c, 0x6f, 0x77, 0x43, 0x61, 0x72, 0x64, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65,
+	0x64, 0x5f, 0x63, 0x61, 0x72, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x72,
+	0x65, 0x64, 0x43, 0x61, 0x72, 0x64, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x70, 0x65, 0x6e, 0x61, 0x6c,
+	0x74, 0x69, 0x65, 0x73, 0x5f, 0x61, 0x77, 0x61, 0x72, 0x64, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x10, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x69, 0x65, 0x73, 0x41, 0x77, 0x61,
+	0x72, 0x64, 0x65, 0x64, 0x12, 0x31, 0x0a, 0x15, 0x79, 0x65, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x63,
+	0x61, 0x72, 0x64, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x67, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x12, 0x79, 0x65, 0x6c, 0x6c, 0x6f, 0x77, 0x43, 0x61, 0x72, 0x64, 0x73,
+	0x50, 0x65, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x12, 0x72, 0x65, 0x64, 0x5f, 0x63,
+	0x61, 0x72, 0x64, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x67, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0f, 0x72, 0x65, 0x64, 0x43, 0x61, 0x72, 0x64, 0x73, 0x50, 0x65, 0x72,
+	0x47, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x63, 0x61, 0x72, 0x64, 0x73, 0x5f, 0x70, 0x65,
+	0x72, 0x5f, 0x67, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x63, 0x61,
+	0x72, 0x64, 0x73, 0x50, 0x65, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x1a, 0x70, 0x65,
+	0x6e, 0x61, 0x6c, 0x74, 0x69, 0x65, 0x73, 0x5f, 0x61, 0x77, 0x61, 0x72, 0x64, 0x65, 0x64, 0x5f,
+	0x70, 0x65, 0x72, 0x5f, 0x67, 0x61, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x17,
+	0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x69, 0x65, 0x73, 0x41, 0x77, 0x61, 0x72, 0x64, 0x65, 0x64,
+	0x50, 0x65, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x22, 0x15, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x48,
+	0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x08, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74,
+	0x65, 0x63, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x65, 0x52, 0x08,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x65, 0x73, 0x22, 0x23, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x52,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x44, 0x0a,
+	0x12, 0x47, 0x65, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x07, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x63, 0x68, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x65, 0x52, 0x07, 0x72, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x65, 0x22, 0x2b, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x65, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0x54, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x65, 0x4d,
+	0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36,
+	0x0a, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x63, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61,
+	0x74, 0x63, 0x68, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x6d,
+	0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x22, 0x28, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x52, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0x4d, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x70, 0x6f,
+	0x72, 0x74, 0x65, 0x63, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x69, 0x70, 0x6c,
+	0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x32,
+	0xf9, 0x02, 0x0a, 0x0e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x53, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x65, 0x73, 0x12, 0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x63, 0x68, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x63, 0x68, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x52, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x65, 0x12, 0x1e, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x63, 0x68,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x63, 0x68,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x65, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x12, 0x26, 0x2e, 0x73,
+	0x70, 0x6f, 0x72, 0x74, 0x65, 0x63, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x65, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x63, 0x68, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x65, 0x4d, 0x61,
+	0x74, 0x63, 0x68, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a,
+	0x0f, 0x47, 0x65, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x12, 0x23, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x63, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x63, 0x68,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x39, 0x5a, 0x37, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x63, 0x68, 0x61, 0x75, 0x68,
+	0x61, 0x6e, 0x39, 0x2f, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x63, 0x68, 0x2f, 0x70, 0x62, 0x2f,
+	0x73, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x63, 0x68, 0x2f, 0x76, 0x31, 0x3b, 0x73, 0x70, 0x6f, 0x72,
+	0x74, 0x65, 0x63, 0x68, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_sportech_v1_referees_proto_rawDescOnce sync.Once
+	file_sportech_v1_referees_proto_rawDescData = file_sportech_v1_referees_proto_rawDesc
+)
+
+func file_sportech_v1_referees_proto_rawDescGZIP() []byte {
+	file_sportech_v1_referees_proto_rawDescOnce.Do(func() {
+		file_sportech_v1_referees_proto_rawDescData = protoimpl.X.CompressGZIP(file_sportech_v1_referees_proto_rawDescData)
+	})
+	return file_sportech_v1_referees_proto_rawDescData
+}
+
+var file_sportech_v1_referees_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_sportech_v1_referees_proto_goTypes = []interface{}{
+	(*Referee)(nil),                    // 0: sportech.v1.Referee
+	(*MatchAssignment)(nil),            // 1: sportech.v1.MatchAssignment
+	(*DisciplineStats)(nil),            // 2: sportech.v1.DisciplineStats
+	(*ListRefereesRequest)(nil),        // 3: sportech.v1.ListRefereesRequest
+	(*ListRefereesResponse)(nil),       // 4: sportech.v1.ListRefereesResponse
+	(*GetRefereeRequest)(nil),          // 5: sportech.v1.GetRefereeRequest
+	(*GetRefereeResponse)(nil),         // 6: sportech.v1.GetRefereeResponse
+	(*ListRefereeMatchesRequest)(nil),  // 7: sportech.v1.ListRefereeMatchesRequest
+	(*ListRefereeMatchesResponse)(nil), // 8: sportech.v1.ListRefereeMatchesResponse
+	(*GetRefereeStatsRequest)(nil),     // 9: sportech.v1.GetRefereeStatsRequest
+	(*GetRefereeStatsResponse)(nil),    // 10: sportech.v1.GetRefereeStatsResponse
+	(*timestamppb.Timestamp)(nil),      // 11: google.protobuf.Timestamp
+	(*Alias)(nil),                      // 12: sportech.v1.Alias
+}
+var file_sportech_v1_referees_proto_depIdxs = []int32{
+	11, // 0: sportech.v1.Referee.date_of_birth:type_name -> google.protobuf.Timestamp
+	12, // 1: sportech.v1.Referee.aliases:type_name -> sportech.v1.Alias
+	11, // 2: sportech.v1.Referee.started:type_name -> google.protobuf.Timestamp
+	11, // 3: sportech.v1.Referee.ended:type_name -> google.protobuf.Timestamp
+	11, // 4: sportech.v1.MatchAssignment.kickoff:type_name -> google.protobuf.Timestamp
+	0,  // 5: sportech.v1.ListRefereesResponse.referees:type_name -> sportech.v1.Referee
+	0,  // 6: sportech.v1.GetRefereeResponse.referee:type_name -> sportech.v1.Referee
+	1,  // 7: sportech.v1.ListRefereeMatchesResponse.matches:type_name -> sportech.v1.MatchAssignment
+	2,  // 8: sportech.v1.GetRefereeStatsResponse.stats:type_name -> sportech.v1.DisciplineStats
+	3,  // 9: sportech.v1.RefereeService.ListReferees:input_type -> sportech.v1.ListRefereesRequest
+	5,  // 10: sportech.v1.RefereeService.GetReferee:input_type -> sportech.v1.GetRefereeRequest
+	7,  // 11: sportech.v1.RefereeService.ListRefereeMatches:input_type -> sportech.v1.ListRefereeMatchesRequest
+	9,  // 12: sportech.v1.RefereeService.GetRefereeStats:input_type -> sportech.v1.GetRefereeStatsRequest
+	4,  // 13: sportech.v1.RefereeService.ListReferees:output_type -> sportech.v1.ListRefereesResponse
+	6,  // 14: sportech.v1.RefereeService.GetReferee:output_type -> sportech.v1.GetRefereeResponse
+	8,  // 15: sportech.v1.RefereeService.ListRefereeMatches:output_type -> sportech.v1.ListRefereeMatchesResponse
+	10, // 16: sportech.v1.RefereeService.GetRefereeStats:output_type -> sportech.v1.GetRefereeStatsResponse
+	13, // [13:17] is the sub-list for method output_type
+	9,  // [9:13] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_sportech_v1_referees_proto_init() }
+func file_sportech_v1_referees_proto_init() {
+	if File_sportech_v1_referees_proto != nil {
+		return
+	}
+	file_sportech_v1_common_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_sportech_v1_referees_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Referee); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sportech_v1_referees_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MatchAssignment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sportech_v1_referees_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisciplineStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sportech_v1_referees_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRefereesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sportech_v1_referees_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRefereesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sportech_v1_referees_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRefereeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sportech_v1_referees_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRefereeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sportech_v1_referees_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRefereeMatchesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sportech_v1_referees_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRefereeMatchesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sportech_v1_referees_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRefereeStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sportech_v1_referees_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRefereeStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_sportech_v1_referees_proto_msgTypes[0].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_sportech_v1_referees_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_sportech_v1_referees_proto_goTypes,
+		DependencyIndexes: file_sportech_v1_referees_proto_depIdxs,
+		MessageInfos:      file_sportech_v1_referees_proto_msgTypes,
+	}.Build()
+	File_sportech_v1_referees_proto = out.File
+	file_sportech_v1_referees_proto_rawDesc = nil
+	file_sportech_v1_referees_proto_goTypes = nil
+	file_sportech_v1_referees_proto_depIdxs = nil
+}
@@ -0,0 +1,213 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             (unknown)
+// source: sportech/v1/referees.proto
+
+package sportechv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// RefereeServiceClient is the client API for RefereeService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RefereeServiceClient interface {
+	ListReferees(ctx context.Context, in *ListRefereesRequest, opts ...grpc.CallOption) (*ListRefereesResponse, error)
+	GetReferee(ctx context.Context, in *GetRefereeRequest, opts ...grpc.CallOption) (*GetRefereeResponse, error)
+	ListRefereeMatches(ctx context.Context, in *ListRefereeMatchesRequest, opts ...grpc.CallOption) (*ListRefereeMatchesResponse, error)
+	GetRefereeStats(ctx context.Context, in *GetRefereeStatsRequest, opts ...grpc.CallOption) (*GetRefereeStatsResponse, error)
+}
+
+type refereeServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRefereeServiceClient(cc grpc.ClientConnInterface) RefereeServiceClient {
+	return &refereeServiceClient{cc}
+}
+
+func (c *refereeServiceClient) ListReferees(ctx context.Context, in *ListRefereesRequest, opts ...grpc.CallOption) (*ListRefereesResponse, error) {
+	out := new(ListRefereesResponse)
+	err := c.cc.Invoke(ctx, "/sportech.v1.RefereeService/ListReferees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *refereeServiceClient) GetReferee(ctx context.Context, in *GetRefereeRequest, opts ...grpc.CallOption) (*GetRefereeResponse, error) {
+	out := new(GetRefereeResponse)
+	err := c.cc.Invoke(ctx, "/sportech.v1.RefereeService/GetReferee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *refereeServiceClient) ListRefereeMatches(ctx context.Context, in *ListRefereeMatchesRequest, opts ...grpc.CallOption) (*ListRefereeMatchesResponse, error) {
+	out := new(ListRefereeMatchesResponse)
+	err := c.cc.Invoke(ctx, "/sportech.v1.RefereeService/ListRefereeMatches", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *refereeServiceClient) GetRefereeStats(ctx context.Context, in *GetRefereeStatsRequest, opts ...grpc.CallOption) (*GetRefereeStatsResponse, error) {
+	out := new(GetRefereeStatsResponse)
+	err := c.cc.Invoke(ctx, "/sportech.v1.RefereeService/GetRefereeStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RefereeServiceServer is the server API for RefereeService service.
+// All implementations must embed UnimplementedRefereeServiceServer
+// for forward compatibility
+type RefereeServiceServer interface {
+	ListReferees(context.Context, *ListRefereesRequest) (*ListRefereesResponse, error)
+	GetReferee(context.Context, *GetRefereeRequest) (*GetRefereeResponse, error)
+	ListRefereeMatches(context.Context, *ListRefereeMatchesRequest) (*ListRefereeMatchesResponse, error)
+	GetRefereeStats(context.Context, *GetRefereeStatsRequest) (*GetRefereeStatsResponse, error)
+	mustEmbedUnimplementedRefereeServiceServer()
+}
+
+// UnimplementedRefereeServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedRefereeServiceServer struct {
+}
+
+func (UnimplementedRefereeServiceServer) ListReferees(context.Context, *ListRefereesRequest) (*ListRefereesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReferees not implemented")
+}
+func (UnimplementedRefereeServiceServer) GetReferee(context.Context, *GetRefereeRequest) (*GetRefereeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReferee not implemented")
+}
+func (UnimplementedRefereeServiceServer) ListRefereeMatches(context.Context, *ListRefereeMatchesRequest) (*ListRefereeMatchesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRefereeMatches not implemented")
+}
+func (UnimplementedRefereeServiceServer) GetRefereeStats(context.Context, *GetRefereeStatsRequest) (*GetRefereeStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRefereeStats not implemented")
+}
+func (UnimplementedRefereeServiceServer) mustEmbedUnimplementedRefereeServiceServer() {}
+
+// UnsafeRefereeServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RefereeServiceServer will
+// result in compilation errors.
+type UnsafeRefereeServiceServer interface {
+	mustEmbedUnimplementedRefereeServiceServer()
+}
+
+func RegisterRefereeServiceServer(s grpc.ServiceRegistrar, srv RefereeServiceServer) {
+	s.RegisterService(&RefereeService_ServiceDesc, srv)
+}
+
+func _RefereeService_ListReferees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRefereesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RefereeServiceServer).ListReferees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sportech.v1.RefereeService/ListReferees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RefereeServiceServer).ListReferees(ctx, req.(*ListRefereesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RefereeService_GetReferee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRefereeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RefereeServiceServer).GetReferee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sportech.v1.RefereeService/GetReferee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RefereeServiceServer).GetReferee(ctx, req.(*GetRefereeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RefereeService_ListRefereeMatches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRefereeMatchesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RefereeServiceServer).ListRefereeMatches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sportech.v1.RefereeService/ListRefereeMatches",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RefereeServiceServer).ListRefereeMatches(ctx, req.(*ListRefereeMatchesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RefereeService_GetRefereeStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRefereeStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RefereeServiceServer).GetRefereeStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sportech.v1.RefereeService/GetRefereeStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RefereeServiceServer).GetRefereeStats(ctx, req.(*GetRefereeStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RefereeService_ServiceDesc is the grpc.ServiceDesc for RefereeService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RefereeService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "sportech.v1.RefereeService",
+	HandlerType: (*RefereeServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListReferees",
+			Handler:    _RefereeService_ListReferees_Handler,
+		},
+		{
+			MethodName: "GetReferee",
+			Handler:    _RefereeService_GetReferee_Handler,
+		},
+		{
+			MethodName: "ListRefereeMatches",
+			Handler:    _RefereeService_ListRefereeMatches_Handler,
+		},
+		{
+			MethodName: "GetRefereeStats",
+			Handler:    _RefereeService_GetRefereeStats_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "sportech/v1/referees.proto",
+}
@@ -0,0 +1,877 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1-devel
+// 	protoc        (unknown)
+// source: sportech/v1/stadiums.proto
+
+package sportechv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Stadium struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name       string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Capacity   int32    `protobuf:"varint,3,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	City       string   `protobuf:"bytes,4,opt,name=city,proto3" json:"city,omitempty"`
+	Country    string   `protobuf:"bytes,5,opt,name=country,proto3" json:"country,omitempty"`
+	Latitude   *float64 `protobuf:"fixed64,6,opt,name=latitude,proto3,oneof" json:"latitude,omitempty"`
+	Longitude  *float64 `protobuf:"fixed64,7,opt,name=longitude,proto3,oneof" json:"longitude,omitempty"`
+	Elevation  *int32   `protobuf:"varint,8,opt,name=elevation,proto3,oneof" json:"elevation,omitempty"`
+	Surface    *string  `protobuf:"bytes,9,opt,name=surface,proto3,oneof" json:"surface,omitempty"`
+	YearOpened *int32   `protobuf:"varint,10,opt,name=year_opened,json=yearOpened,proto3,oneof" json:"year_opened,omitempty"`
+	DistanceKm *float64 `protobuf:"fixed64,11,opt,name=distance_km,json=distanceKm,proto3,oneof" json:"distance_km,omitempty"`
+}
+
+func (x *Stadium) Reset() {
+	*x = Stadium{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sportech_v1_stadiums_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Stadium) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Stadium) ProtoMessage() {}
+
+func (x *Stadium) ProtoReflect() protoreflect.Message {
+	mi := &file_sportech_v1_stadiums_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Stadium.ProtoReflect.Descriptor instead.
+func (*Stadium) Descriptor() ([]byte, []int) {
+	return file_sportech_v1_stadiums_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Stadium) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Stadium) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Stadium) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *Stadium) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *Stadium) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *Stadium) GetLatitude() float64 {
+	if x != nil && x.Latitude != nil {
+		return *x.Latitude
+	}
+	return 0
+}
+
+func (x *Stadium) GetLongitude() float64 {
+	if x != nil && x.Longitude != nil {
+		return *x.Longitude
+	}
+	return 0
+}
+
+func (x *Stadium) GetElevation() int32 {
+	if x != nil && x.Elevation != nil {
+		return *x.Elevation
+	}
+	return 0
+}
+
+func (x *Stadium) GetSurface() string {
+	if x != nil && x.Surface != nil {
+		return *x.Surface
+	}
+	return ""
+}
+
+func (x *Stadium) GetYearOpened() int32 {
+	if x != nil && x.YearOpened != nil {
+		return *x.YearOpened
+	}
+	return 0
+}
+
+func (x *Stadium) GetDistanceKm() float64 {
+	if x != nil && x.DistanceKm != nil {
+		return *x.DistanceKm
+	}
+	return 0
+}
+
+type Point struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Latitude  float64 `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude float64 `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+}
+
+func (x *Point) Reset() {
+	*x = Point{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sportech_v1_stadiums_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Point) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Point) ProtoMessage() {}
+
+func (x *Point) ProtoReflect() protoreflect.Message {
+	mi := &file_sportech_v1_stadiums_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Point.ProtoReflect.Descriptor instead.
+func (*Point) Descriptor() ([]byte, []int) {
+	return file_sportech_v1_stadiums_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Point) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *Point) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+// A team that plays, or played, its home games at a stadium. Ended is the last day of the
+// tenancy, or unset if it is ongoing.
+type Tenant struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Team    string                 `protobuf:"bytes,2,opt,name=team,proto3" json:"team,omitempty"`
+	Started *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=started,proto3" json:"started,omitempty"`
+	Ended   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=ended,proto3" json:"ended,omitempty"`
+}
+
+func (x *Tenant) Reset() {
+	*x = Tenant{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sportech_v1_stadiums_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Tenant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tenant) ProtoMessage() {}
+
+func (x *Tenant) ProtoReflect() protoreflect.Message {
+	mi := &file_sportech_v1_stadiums_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tenant.ProtoReflect.Descriptor instead.
+func (*Tenant) Descriptor() ([]byte, []int) {
+	return file_sportech_v1_stadiums_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Tenant) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Tenant) GetTeam() string {
+	if x != nil {
+		return x.Team
+	}
+	return ""
+}
+
+func (x *Tenant) GetStarted() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Started
+	}
+	return nil
+}
+
+func (x *Tenant) GetEnded() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Ended
+	}
+	return nil
+}
+
+// Name filters stadiums by current or former name. Otherwise, if near is set, stadiums are
+// ordered by distance from it and limited to radius_km when that is set too.
+type ListStadiumsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Near     *Point   `protobuf:"bytes,2,opt,name=near,proto3" json:"near,omitempty"`
+	RadiusKm *float64 `protobuf:"fixed64,3,opt,name=radius_km,json=radiusKm,proto3,oneof" json:"radius_km,omitempty"`
+}
+
+func (x *ListStadiumsRequest) Reset() {
+	*x = ListStadiumsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sportech_v1_stadiums_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListStadiumsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStadiumsRequest) ProtoMessage() {}
+
+func (x *ListStadiumsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sportech_v1_stadiums_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStadiumsRequest.ProtoReflect.Descriptor instead.
+func (*ListStadiumsRequest) Descriptor() ([]byte, []int) {
+	return file_sportech_v1_stadiums_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListStadiumsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListStadiumsRequest) GetNear() *Point {
+	if x != nil {
+		return x.Near
+	}
+	return nil
+}
+
+func (x *ListStadiumsRequest) GetRadiusKm() float64 {
+	if x != nil && x.RadiusKm != nil {
+		return *x.RadiusKm
+	}
+	return 0
+}
+
+type ListStadiumsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Stadiums []*Stadium `protobuf:"bytes,1,rep,name=stadiums,proto3" json:"stadiums,omitempty"`
+}
+
+func (x *ListStadiumsResponse) Reset() {
+	*x = ListStadiumsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sportech_v1_stadiums_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListStadiumsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStadiumsResponse) ProtoMessage() {}
+
+func (x *ListStadiumsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sportech_v1_stadiums_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStadiumsResponse.ProtoReflect.Descriptor instead.
+func (*ListStadiumsResponse) Descriptor() ([]byte, []int) {
+	return file_sportech_v1_stadiums_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListStadiumsResponse) GetStadiums() []*Stadium {
+	if x != nil {
+		return x.Stadiums
+	}
+	return nil
+}
+
+type GetStadiumRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AsOf string `protobuf:"bytes,2,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`
+}
+
+func (x *GetStadiumRequest) Reset() {
+	*x = GetStadiumRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sportech_v1_stadiums_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStadiumRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStadiumRequest) ProtoMessage() {}
+
+func (x *GetStadiumRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sportech_v1_stadiums_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStadiumRequest.ProtoReflect.Descriptor instead.
+func (*GetStadiumRequest) Descriptor() ([]byte, []int) {
+	return file_sportech_v1_stadiums_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetStadiumRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetStadiumRequest) GetAsOf() string {
+	if x != nil {
+		return x.AsOf
+	}
+	return ""
+}
+
+type GetStadiumResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Stadium *Stadium `protobuf:"bytes,1,opt,name=stadium,proto3" json:"stadium,omitempty"`
+}
+
+func (x *GetStadiumResponse) Reset() {
+	*x = GetStadiumResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sportech_v1_stadiums_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStadiumResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStadiumResponse) ProtoMessage() {}
+
+func (x *GetStadiumResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sportech_v1_stadiums_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStadiumResponse.ProtoReflect.Descriptor instead.
+func (*GetStadiumResponse) Descriptor() ([]byte, []int) {
+	return file_sportech_v1_stadiums_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetStadiumResponse) GetStadium() *Stadium {
+	if x != nil {
+		return x.Stadium
+	}
+	return nil
+}
+
+type ListStadiumTenantsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AsOf string `protobuf:"bytes,2,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`
+}
+
+func (x *ListStadiumTenantsRequest) Reset() {
+	*x = ListStadiumTenantsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sportech_v1_stadiums_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListStadiumTenantsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStadiumTenantsRequest) ProtoMessage() {}
+
+func (x *ListStadiumTenantsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sportech_v1_stadiums_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStadiumTenantsRequest.ProtoReflect.Descriptor instead.
+func (*ListStadiumTenantsRequest) Descriptor() ([]byte, []int) {
+	return file_sportech_v1_stadiums_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListStadiumTenantsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ListStadiumTenantsRequest) GetAsOf() string {
+	if x != nil {
+		return x.AsOf
+	}
+	return ""
+}
+
+type ListStadiumTenantsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tenants []*Tenant `protobuf:"bytes,1,rep,name=tenants,proto3" json:"tenants,omitempty"`
+}
+
+func (x *ListStadiumTenantsResponse) Reset() {
+	*x = ListStadiumTenantsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sportech_v1_stadiums_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListStadiumTenantsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStadiumTenantsResponse) ProtoMessage() {}
+
+func (x *ListStadiumTenantsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sportech_v1_stadiums_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStadiumTenantsResponse.ProtoReflect.Descriptor instead.
+func (*ListStadiumTenantsResponse) Descriptor() ([]byte, []int) {
+	return file_sportech_v1_stadiums_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListStadiumTenantsResponse) GetTenants() []*Tenant {
+	if x != nil {
+		return x.Tenants
+	}
+	return nil
+}
+
+var File_sportech_v1_stadiums_proto protoreflect.FileDescriptor
+
+var file_sportech_v1_stadiums_proto_rawDesc = []byte{
+	0x0a, 0x1a, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x63, 0x68, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74,
+	0x61, 0x64, 0x69, 0x75, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x73, 0x70,
+	0x6f, 0x72, 0x74, 0x65, 0x63, 0x68, 0x2e, 0x76, 0x31, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9e, 0x03, 0x0a, 0x07, 0x53,
+	0x74, 0x61, 0x64, 0x69, 0x75, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61,
+	0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x63, 0x61,
+	0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x69, 0x74, 0x79, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x1f, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75,
+	0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75,
+	0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x48, 0x01, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67,
+	0x69, 0x74, 0x75, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x65, 0x6c, 0x65, 0x76,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x48, 0x02, 0x52, 0x09, 0x65,
+	0x6c, 0x65, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x73,
+	0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x07,
+	0x73, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b, 0x79, 0x65,
+	0x61, 0x72, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x48,
+	0x04, 0x52, 0x0a, 0x79, 0x65, 0x61, 0x72, 0x4f, 0x70, 0x65, 0x6e, 0x65, 0x64, 0x88, 0x01, 0x01,
+	0x12, 0x24, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6b, 0x6d, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x01, 0x48, 0x05, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x4b, 0x6d, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6c, 0x61, 0x74, 0x69, 0x74,
+	0x75, 0x64, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64,
+	0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42,
+	0x0a, 0x0a, 0x08, 0x5f, 0x73, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f,
+	0x79, 0x65, 0x61, 0x72, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x65, 0x64, 0x42, 0x0e, 0x0a, 0x0c, 0x5f,
+	0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6b, 0x6d, 0x22, 0x41, 0x0a, 0x05, 0x50,
+	0x6f, 0x69, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x22, 0x94,
+	0x01, 0x0a, 0x06, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x61,
+	0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x61, 0x6d, 0x12, 0x34, 0x0a,
+	0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x65, 0x64, 0x12, 0x30, 0x0a, 0x05, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05,
+	0x65, 0x6e, 0x64, 0x65, 0x64, 0x22, 0x81, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74,
+	0x61, 0x64, 0x69, 0x75, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x26, 0x0a, 0x04, 0x6e, 0x65, 0x61, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x63, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f,
+	0x69, 0x6e, 0x74, 0x52, 0x04, 0x6e, 0x65, 0x61, 0x72, 0x12, 0x20, 0x0a, 0x09, 0x72, 0x61, 0x64,
+	0x69, 0x75, 0x73, 0x5f, 0x6b, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x08,
+	0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x4b, 0x6d, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f,
+	0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x5f, 0x6b, 0x6d, 0x22, 0x48, 0x0a, 0x14, 0x4c, 0x69, 0x73,
+	0x74, 0x53, 0x74, 0x61, 0x64, 0x69, 0x75, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x30, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x64, 0x69, 0x75, 0x6d, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x63, 0x68, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x74, 0x61, 0x64, 0x69, 0x75, 0x6d, 0x52, 0x08, 0x73, 0x74, 0x61, 0x64, 0x69,
+	0x75, 0x6d, 0x73, 0x22, 0x38, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x64, 0x69, 0x75,
+	0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x13, 0x0a, 0x05, 0x61, 0x73, 0x5f, 0x6f,
+	0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x73, 0x4f, 0x66, 0x22, 0x44, 0x0a,
+	0x12, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x64, 0x69, 0x75, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x64, 0x69, 0x75, 0x6d, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x63, 0x68, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x64, 0x69, 0x75, 0x6d, 0x52, 0x07, 0x73, 0x74, 0x61, 0x64,
+	0x69, 0x75, 0x6d, 0x22, 0x40, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x64, 0x69,
+	0x75, 0x6d, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x13, 0x0a, 0x05, 0x61, 0x73, 0x5f, 0x6f, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x61, 0x73, 0x4f, 0x66, 0x22, 0x4b, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61,
+	0x64, 0x69, 0x75, 0x6d, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x07, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x63, 0x68, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x07, 0x74, 0x65, 0x6e, 0x61, 0x6e,
+	0x74, 0x73, 0x32, 0x9b, 0x02, 0x0a, 0x0e, 0x53, 0x74, 0x61, 0x64, 0x69, 0x75, 0x6d, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x53, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61,
+	0x64, 0x69, 0x75, 0x6d, 0x73, 0x12, 0x20, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x63, 0x68,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x64, 0x69, 0x75, 0x6d, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x65,
+	0x63, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x64, 0x69, 0x75,
+	0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0a, 0x47, 0x65,
+	0x74, 0x53, 0x74, 0x61, 0x64, 0x69, 0x75, 0x6d, 0x12, 0x1e, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74,
+	0x65, 0x63, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x64, 0x69, 0x75,
+	0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74,
+	0x65, 0x63, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x64, 0x69, 0x75,
+	0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x12, 0x4c, 0x69, 0x73,
+	0x74, 0x53, 0x74, 0x61, 0x64, 0x69, 0x75, 0x6d, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x12,
+	0x26, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x63, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x53, 0x74, 0x61, 0x64, 0x69, 0x75, 0x6d, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x65,
+	0x63, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x64, 0x69, 0x75,
+	0x6d, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x42, 0x39, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72,
+	0x63, 0x68, 0x61, 0x75, 0x68, 0x61, 0x6e, 0x39, 0x2f, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x63,
+	0x68, 0x2f, 0x70, 0x62, 0x2f, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x63, 0x68, 0x2f, 0x76, 0x31,
+	0x3b, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x63, 0x68, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_sportech_v1_stadiums_proto_rawDescOnce sync.Once
+	file_sportech_v1_stadiums_proto_rawDescData = file_sportech_v1_stadiums_proto_rawDesc
+)
+
+func file_sportech_v1_stadiums_proto_rawDescGZIP() []byte {
+	file_sportech_v1_stadiums_proto_rawDescOnce.Do(func() {
+		file_sportech_v1_stadiums_proto_rawDescData = protoimpl.X.CompressGZIP(file_sportech_v1_stadiums_proto_rawDescData)
+	})
+	return file_sportech_v1_stadiums_proto_rawDescData
+}
+
+var file_sportech_v1_stadiums_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_sportech_v1_stadiums_proto_goTypes = []interface{}{
+	(*Stadium)(nil),                    // 0: sportech.v1.Stadium
+	(*Point)(nil),                      // 1: sportech.v1.Point
+	(*Tenant)(nil),                     // 2: sportech.v1.Tenant
+	(*ListStadiumsRequest)(nil),        // 3: sportech.v1.ListStadiumsRequest
+	(*ListStadiumsResponse)(nil),       // 4: sportech.v1.ListStadiumsResponse
+	(*GetStadiumRequest)(nil),          // 5: sportech.v1.GetStadiumRequest
+	(*GetStadiumResponse)(nil),         // 6: sportech.v1.GetStadiumResponse
+	(*ListStadiumTenantsRequest)(nil),  // 7: sportech.v1.ListStadiumTenantsRequest
+	(*ListStadiumTenantsResponse)(nil), // 8: sportech.v1.ListStadiumTenantsResponse
+	(*timestamppb.Timestamp)(nil),      // 9: google.protobuf.Timestamp
+}
+var file_sportech_v1_stadiums_proto_depIdxs = []int32{
+	9, // 0: sportech.v1.Tenant.started:type_name -> google.protobuf.Timestamp
+	9, // 1: sportech.v1.Tenant.ended:type_name -> google.protobuf.Timestamp
+	1, // 2: sportech.v1.ListStadiumsRequest.near:type_name -> sportech.v1.Point
+	0, // 3: sportech.v1.ListStadiumsResponse.stadiums:type_name -> sportech.v1.Stadium
+	0, // 4: sportech.v1.GetStadiumResponse.stadium:type_name -> sportech.v1.Stadium
+	2, // 5: sportech.v1.ListStadiumTenantsResponse.tenants:type_name -> sportech.v1.Tenant
+	3, // 6: sportech.v1.StadiumService.ListStadiums:input_type -> sportech.v1.ListStadiumsRequest
+	5, // 7: sportech.v1.StadiumService.GetStadium:input_type -> sportech.v1.GetStadiumRequest
+	7, // 8: sportech.v1.StadiumService.ListStadiumTenants:input_type -> sportech.v1.ListStadiumTenantsRequest
+	4, // 9: sportech.v1.StadiumService.ListStadiums:output_type -> sportech.v1.ListStadiumsResponse
+	6, // 10: sportech.v1.StadiumService.GetStadium:output_type -> sportech.v1.GetStadiumResponse
+	8, // 11: sportech.v1.StadiumService.ListStadiumTenants:output_type -> sportech.v1.ListStadiumTenantsResponse
+	9, // [9:12] is the sub-list for method output_type
+	6, // [6:9] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_sportech_v1_stadiums_proto_init() }
+func file_sportech_v1_stadiums_proto_init() {
+	if File_sportech_v1_stadiums_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_sportech_v1_stadiums_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Stadium); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sportech_v1_stadiums_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Point); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sportech_v1_stadiums_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Tenant); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sportech_v1_stadiums_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListStadiumsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sportech_v1_stadiums_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListStadiumsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sportech_v1_stadiums_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStadiumRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sportech_v1_stadiums_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStadiumResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sportech_v1_stadiums_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListStadiumTenantsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sportech_v1_stadiums_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListStadiumTenantsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_sportech_v1_stadiums_proto_msgTypes[0].OneofWrappers = []interface{}{}
+	file_sportech_v1_stadiums_proto_msgTypes[3].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_sportech_v1_stadiums_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_sportech_v1_stadiums_proto_goTypes,
+		DependencyIndexes: file_sportech_v1_stadiums_proto_depIdxs,
+		MessageInfos:      file_sportech_v1_stadiums_proto_msgTypes,
+	}.Build()
+	File_sportech_v1_stadiums_proto = out.File
+	file_sportech_v1_stadiums_proto_rawDesc = nil
+	file_sportech_v1_stadiums_proto_goTypes = nil
+	file_sportech_v1_stadiums_proto_depIdxs = nil
+}
@@ -0,0 +1,177 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             (unknown)
+// source: sportech/v1/stadiums.proto
+
+package sportechv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// StadiumServiceClient is the client API for StadiumService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type StadiumServiceClient interface {
+	ListStadiums(ctx context.Context, in *ListStadiumsRequest, opts ...grpc.CallOption) (*ListStadiumsResponse, error)
+	GetStadium(ctx context.Context, in *GetStadiumRequest, opts ...grpc.CallOption) (*GetStadiumResponse, error)
+	ListStadiumTenants(ctx context.Context, in *ListStadiumTenantsRequest, opts ...grpc.CallOption) (*ListStadiumTenantsResponse, error)
+}
+
+type stadiumServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewStadiumServiceClient(cc grpc.ClientConnInterface) StadiumServiceClient {
+	return &stadiumServiceClient{cc}
+}
+
+func (c *stadiumServiceClient) ListStadiums(ctx context.Context, in *ListStadiumsRequest, opts ...grpc.CallOption) (*ListStadiumsResponse, error) {
+	out := new(ListStadiumsResponse)
+	err := c.cc.Invoke(ctx, "/sportech.v1.StadiumService/ListStadiums", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stadiumServiceClient) GetStadium(ctx context.Context, in *GetStadiumRequest, opts ...grpc.CallOption) (*GetStadiumResponse, error) {
+	out := new(GetStadiumResponse)
+	err := c.cc.Invoke(ctx, "/sportech.v1.StadiumService/GetStadium", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stadiumServiceClient) ListStadiumTenants(ctx context.Context, in *ListStadiumTenantsRequest, opts ...grpc.CallOption) (*ListStadiumTenantsResponse, error) {
+	out := new(ListStadiumTenantsResponse)
+	err := c.cc.Invoke(ctx, "/sportech.v1.StadiumService/ListStadiumTenants", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// StadiumServiceServer is the server API for StadiumService service.
+// All implementations must embed UnimplementedStadiumServiceServer
+// for forward compatibility
+type StadiumServiceServer interface {
+	ListStadiums(context.Context, *ListStadiumsRequest) (*ListStadiumsResponse, error)
+	GetStadium(context.Context, *GetStadiumRequest) (*GetStadiumResponse, error)
+	ListStadiumTenants(context.Context, *ListStadiumTenantsRequest) (*ListStadiumTenantsResponse, error)
+	mustEmbedUnimplementedStadiumServiceServer()
+}
+
+// UnimplementedStadiumServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedStadiumServiceServer struct {
+}
+
+func (UnimplementedStadiumServiceServer) ListStadiums(context.Context, *ListStadiumsRequest) (*ListStadiumsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStadiums not implemented")
+}
+func (UnimplementedStadiumServiceServer) GetStadium(context.Context, *GetStadiumRequest) (*GetStadiumResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStadium not implemented")
+}
+func (UnimplementedStadiumServiceServer) ListStadiumTenants(context.Context, *ListStadiumTenantsRequest) (*ListStadiumTenantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStadiumTenants not implemented")
+}
+func (UnimplementedStadiumServiceServer) mustEmbedUnimplementedStadiumServiceServer() {}
+
+// UnsafeStadiumServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to StadiumServiceServer will
+// result in compilation errors.
+type UnsafeStadiumServiceServer interface {
+	mustEmbedUnimplementedStadiumServiceServer()
+}
+
+func RegisterStadiumServiceServer(s grpc.ServiceRegistrar, srv StadiumServiceServer) {
+	s.RegisterService(&StadiumService_ServiceDesc, srv)
+}
+
+func _StadiumService_ListStadiums_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStadiumsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StadiumServiceServer).ListStadiums(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sportech.v1.StadiumService/ListStadiums",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StadiumServiceServer).ListStadiums(ctx, req.(*ListStadiumsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StadiumService_GetStadium_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStadiumRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StadiumServiceServer).GetStadium(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sportech.v1.StadiumService/GetStadium",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StadiumServiceServer).GetStadium(ctx, req.(*GetStadiumRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StadiumService_ListStadiumTenants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStadiumTenantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StadiumServiceServer).ListStadiumTenants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sportech.v1.StadiumService/ListStadiumTenants",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StadiumServiceServer).ListStadiumTenants(ctx, req.(*ListStadiumTenantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// StadiumService_ServiceDesc is the grpc.ServiceDesc for StadiumService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var StadiumService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "sportech.v1.StadiumService",
+	HandlerType: (*StadiumServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListStadiums",
+			Handler:    _StadiumService_ListStadiums_Handler,
+		},
+		{
+			MethodName: "GetStadium",
+			Handler:    _StadiumService_GetStadium_Handler,
+		},
+		{
+			MethodName: "ListStadiumTenants",
+			Handler:    _StadiumService_ListStadiumTenants_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "sportech/v1/stadiums.proto",
+}
@@ -0,0 +1,47 @@
+package persons
+
+import (
+	"context"
+	"github.com/rchauhan9/sportech/cache"
+)
+
+// NewCachingService serves reads from store, falling through to next on a miss. GetPersons shares
+// its entries with GetPerson, so people looked up one way are found the other. Merging purges the
+// store straight away rather than waiting for the change notification.
+func NewCachingService(next Service, store *cache.Store) Service {
+	return &cachingService{next: next, store: store}
+}
+
+type cachingService struct {
+	next  Service
+	store *cache.Store
+}
+
+func (s *cachingService) ListPersons(ctx context.Context) ([]Person, error) {
+	return cache.Load(s.store, cache.Key("ListPersons"), func() ([]Person, error) {
+		return s.next.ListPersons(ctx)
+	})
+}
+
+func (s *cachingService) GetPerson(ctx context.Context, id string) (Person, error) {
+	return cache.Load(s.store, cache.Key("GetPerson", id), func() (Person, error) {
+		return s.next.GetPerson(ctx, id)
+	})
+}
+
+func (s *cachingService) GetPersons(ctx context.Context, ids []string) (map[string]Person, error) {
+	return cache.LoadEach(s.store, "GetPerson", ids, func(missing []string) (map[string]Person, error) {
+		return s.next.GetPersons(ctx, missing)
+	})
+}
+
+func (s *cachingService) ListDuplicateCandidates(ctx context.Context, minScore float64, limit int) ([]DuplicateCandidate, error) {
+	return cache.Load(s.store, cache.Key("ListDuplicateCandidates", minScore, limit), func() ([]DuplicateCandidate, error) {
+		return s.next.ListDuplicateCandidates(ctx, minScore, limit)
+	})
+}
+
+func (s *cachingService) MergePersons(ctx context.Context, survivorID string, duplicateIDs []string) (Person, error) {
+	defer s.store.Purge()
+	return s.next.MergePersons(ctx, survivorID, duplicateIDs)
+}
@@ -0,0 +1,59 @@
+package persons
+
+import (
+	"context"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/rchauhan9/sportech/stream"
+)
+
+type getPersonRequest struct {
+	ID string
+}
+
+type getPersonResponse struct {
+	Person Person `json:"person"`
+}
+
+type listDuplicatesRequest struct {
+	MinScore float64
+	Limit    int
+	Format   string
+}
+
+type listDuplicatesResponse struct {
+	Candidates stream.Stream[DuplicateCandidate] `json:"candidates"`
+	format     string
+}
+
+type mergePersonsRequest struct {
+	SurvivorID   string   `json:"-"`
+	DuplicateIDs []string `json:"duplicateIds"`
+}
+
+type mergePersonsResponse struct {
+	Person Person `json:"person"`
+}
+
+func MakeGetPersonEndpoint(svc Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(getPersonRequest)
+		person, err := svc.GetPerson(ctx, req.ID)
+		return getPersonResponse{Person: person}, err
+	}
+}
+
+func MakeListDuplicatesEndpoint(svc Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(listDuplicatesRequest)
+		candidates, err := svc.ListDuplicateCandidates(ctx, req.MinScore, req.Limit)
+		return listDuplicatesResponse{Candidates: stream.Slice(candidates), format: req.Format}, err
+	}
+}
+
+func MakeMergePersonsEndpoint(svc Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(mergePersonsRequest)
+		person, err := svc.MergePersons(ctx, req.SurvivorID, req.DuplicateIDs)
+		return mergePersonsResponse{Person: person}, err
+	}
+}
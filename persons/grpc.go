@@ -0,0 +1,151 @@
+package persons
+
+import (
+	"context"
+	"github.com/go-kit/kit/endpoint"
+	grpctransport "github.com/go-kit/kit/transport/grpc"
+	"github.com/pkg/errors"
+	"github.com/rchauhan9/sportech/auth"
+	pb "github.com/rchauhan9/sportech/pb/sportech/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type grpcServer struct {
+	pb.UnimplementedPersonServiceServer
+	getPerson      grpctransport.Handler
+	listDuplicates grpctransport.Handler
+	mergePersons   grpctransport.Handler
+}
+
+func MakeGRPCServer(getPersonEndpoint endpoint.Endpoint, listDuplicatesEndpoint endpoint.Endpoint, mergePersonsEndpoint endpoint.Endpoint) pb.PersonServiceServer {
+	return &grpcServer{
+		getPerson: grpctransport.NewServer(
+			getPersonEndpoint,
+			decodeGRPCGetPersonRequest,
+			encodeGRPCGetPersonResponse,
+		),
+		listDuplicates: grpctransport.NewServer(
+			listDuplicatesEndpoint,
+			decodeGRPCListDuplicatesRequest,
+			encodeGRPCListDuplicatesResponse,
+		),
+		mergePersons: grpctransport.NewServer(
+			mergePersonsEndpoint,
+			decodeGRPCMergePersonsRequest,
+			encodeGRPCMergePersonsResponse,
+		),
+	}
+}
+
+func (s *grpcServer) GetPerson(ctx context.Context, req *pb.GetPersonRequest) (*pb.GetPersonResponse, error) {
+	_, rep, err := s.getPerson.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeGRPCError(err)
+	}
+	return rep.(*pb.GetPersonResponse), nil
+}
+
+func (s *grpcServer) ListDuplicateCandidates(ctx context.Context, req *pb.ListDuplicateCandidatesRequest) (*pb.ListDuplicateCandidatesResponse, error) {
+	_, rep, err := s.listDuplicates.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeGRPCError(err)
+	}
+	return rep.(*pb.ListDuplicateCandidatesResponse), nil
+}
+
+func (s *grpcServer) MergePersons(ctx context.Context, req *pb.MergePersonsRequest) (*pb.MergePersonsResponse, error) {
+	_, rep, err := s.mergePersons.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeGRPCError(err)
+	}
+	return rep.(*pb.MergePersonsResponse), nil
+}
+
+func decodeGRPCGetPersonRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.GetPersonRequest)
+	return getPersonRequest{ID: req.Id}, nil
+}
+
+func encodeGRPCGetPersonResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(getPersonResponse)
+	return &pb.GetPersonResponse{Person: encodeGRPCPerson(resp.Person)}, nil
+}
+
+func decodeGRPCListDuplicatesRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.ListDuplicateCandidatesRequest)
+	listReq := listDuplicatesRequest{MinScore: DefaultDuplicateMinScore, Limit: int(req.Limit)}
+	if req.MinScore != nil {
+		listReq.MinScore = *req.MinScore
+	}
+	return listReq, nil
+}
+
+func encodeGRPCListDuplicatesResponse(ctx context.Context, response interface{}) (interface{}, error) {
+	resp := response.(listDuplicatesResponse)
+	collected, err := resp.Candidates.Collect(ctx)
+	if err != nil {
+		return nil, encodeGRPCError(err)
+	}
+	candidates := make([]*pb.DuplicateCandidate, len(collected))
+	for i, candidate := range collected {
+		candidates[i] = &pb.DuplicateCandidate{
+			PersonId:         candidate.PersonID,
+			PersonName:       candidate.PersonName,
+			DuplicateId:      candidate.DuplicateID,
+			DuplicateName:    candidate.DuplicateName,
+			Score:            candidate.Score,
+			NameSimilarity:   candidate.NameSimilarity,
+			DateOfBirthScore: candidate.DateOfBirthScore,
+			SameNationality:  candidate.SameNationality,
+		}
+	}
+	return &pb.ListDuplicateCandidatesResponse{Candidates: candidates}, nil
+}
+
+func decodeGRPCMergePersonsRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.MergePersonsRequest)
+	return mergePersonsRequest{SurvivorID: req.SurvivorId, DuplicateIDs: req.DuplicateIds}, nil
+}
+
+func encodeGRPCMergePersonsResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(mergePersonsResponse)
+	return &pb.MergePersonsResponse{Person: encodeGRPCPerson(resp.Person)}, nil
+}
+
+func encodeGRPCPerson(person Person) *pb.Person {
+	return &pb.Person{
+		Id:          person.ID,
+		FirstName:   person.FirstName,
+		MiddleNames: person.MiddleNames,
+		LastName:    person.LastName,
+		KnownAs:     person.KnownAs,
+		DateOfBirth: timestamppb.New(person.DateOfBirth),
+		Nationality: person.Nationality,
+		Aliases:     EncodeGRPCAliases(person.Aliases),
+	}
+}
+
+// EncodeGRPCAliases converts aliases for the gRPC transports of the packages built on persons.
+func EncodeGRPCAliases(aliases []Alias) []*pb.Alias {
+	pbAliases := make([]*pb.Alias, len(aliases))
+	for i, alias := range aliases {
+		pbAliases[i] = &pb.Alias{Name: alias.Name, Locale: alias.Locale}
+	}
+	return pbAliases
+}
+
+// encode errors from business-logic as gRPC statuses
+func encodeGRPCError(err error) error {
+	switch errors.Cause(err) {
+	case ErrInvalidArgument:
+		return status.Error(codes.InvalidArgument, err.Error())
+	case auth.ErrUnauthenticated:
+		return status.Error(codes.Unauthenticated, err.Error())
+	case auth.ErrForbidden:
+		return status.Error(codes.PermissionDenied, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
@@ -0,0 +1,147 @@
+package persons
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/go-kit/kit/endpoint"
+	kithttp "github.com/go-kit/kit/transport/http"
+	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+	"github.com/rchauhan9/sportech/auth"
+	"github.com/rchauhan9/sportech/commons/go/logging"
+	"github.com/rchauhan9/sportech/commons/go/queryutil"
+	"github.com/rchauhan9/sportech/stream"
+	"net/http"
+	"strconv"
+)
+
+func MakeHandler(getPersonEndpoint endpoint.Endpoint, listDuplicatesEndpoint endpoint.Endpoint, mergePersonsEndpoint endpoint.Endpoint) http.Handler {
+	r := mux.NewRouter()
+
+	getPersonHandler := kithttp.NewServer(
+		getPersonEndpoint,
+		decodeGetPersonRequest,
+		encodeGetPersonResponse,
+		kithttp.ServerErrorEncoder(encodeError),
+	)
+
+	listDuplicatesHandler := kithttp.NewServer(
+		listDuplicatesEndpoint,
+		decodeListDuplicatesRequest,
+		encodeListDuplicatesResponse,
+		kithttp.ServerErrorEncoder(encodeError),
+	)
+
+	mergePersonsHandler := kithttp.NewServer(
+		mergePersonsEndpoint,
+		decodeMergePersonsRequest,
+		encodeMergePersonsResponse,
+		kithttp.ServerErrorEncoder(encodeError),
+	)
+
+	r.Handle("/persons/duplicates", listDuplicatesHandler).Methods("GET")
+	r.Handle("/persons/{id}/merge", mergePersonsHandler).Methods("POST")
+	r.Handle("/persons/{id}", getPersonHandler).Methods("GET")
+
+	return r
+}
+
+func decodeGetPersonRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	vars := mux.Vars(r)
+	id, ok := vars["id"]
+	if !ok {
+		return nil, errors.New("bad route")
+	}
+	return getPersonRequest{ID: id}, nil
+}
+
+func encodeGetPersonResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	if e, ok := response.(errorer); ok && e.error() != nil {
+		encodeError(ctx, e.error(), w)
+		return nil
+	}
+	return json.NewEncoder(w).Encode(response)
+}
+
+func decodeListDuplicatesRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	query := r.URL.Query()
+	req := listDuplicatesRequest{MinScore: DefaultDuplicateMinScore}
+
+	if minScore := query.Get("minScore"); minScore != "" {
+		score, err := strconv.ParseFloat(minScore, 64)
+		if err != nil {
+			return nil, errors.Wrapf(ErrInvalidArgument, "invalid minScore %q", minScore)
+		}
+		req.MinScore = score
+	}
+
+	if limit := query.Get("limit"); limit != "" {
+		n, err := strconv.Atoi(limit)
+		if err != nil {
+			return nil, errors.Wrapf(ErrInvalidArgument, "invalid limit %q", limit)
+		}
+		req.Limit = n
+	}
+
+	format, err := queryutil.Format(r, ErrInvalidArgument)
+	if err != nil {
+		return nil, err
+	}
+	req.Format = format
+
+	return req, nil
+}
+
+func encodeListDuplicatesResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	if e, ok := response.(errorer); ok && e.error() != nil {
+		encodeError(ctx, e.error(), w)
+		return nil
+	}
+	resp := response.(listDuplicatesResponse)
+	return stream.Encode(ctx, w, resp.format, "candidates", resp.Candidates)
+}
+
+func decodeMergePersonsRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	vars := mux.Vars(r)
+	id, ok := vars["id"]
+	if !ok {
+		return nil, errors.New("bad route")
+	}
+	var req mergePersonsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, errors.Wrapf(ErrInvalidArgument, "invalid request body: %s", err)
+	}
+	req.SurvivorID = id
+	return req, nil
+}
+
+func encodeMergePersonsResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	if e, ok := response.(errorer); ok && e.error() != nil {
+		encodeError(ctx, e.error(), w)
+		return nil
+	}
+	return json.NewEncoder(w).Encode(response)
+}
+
+type errorer interface {
+	error() error
+}
+
+// encode errors from business-logic
+func encodeError(ctx context.Context, err error, w http.ResponseWriter) {
+	logging.RecordError(ctx, err)
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	switch errors.Cause(err) {
+	case ErrInvalidArgument:
+		w.WriteHeader(http.StatusBadRequest)
+	case auth.ErrUnauthenticated:
+		w.WriteHeader(http.StatusUnauthorized)
+	case auth.ErrForbidden:
+		w.WriteHeader(http.StatusForbidden)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"error": err.Error(),
+	})
+}
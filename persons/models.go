@@ -3,10 +3,40 @@ package persons
 import "time"
 
 type Person struct {
-	ID          string
-	FirstName   string
-	MiddleNames *string
-	LastName    string
-	DateOfBirth time.Time
-	Nationality string
+	ID          string    `json:"id"`
+	FirstName   string    `json:"firstName"`
+	MiddleNames *string   `json:"middleNames"`
+	LastName    string    `json:"lastName"`
+	KnownAs     *string   `json:"knownAs"`
+	DateOfBirth time.Time `json:"dateOfBirth"`
+	Nationality string    `json:"nationality"`
+	Aliases     []Alias   `json:"aliases"`
+}
+
+// Alias is another name a person goes by. Locale is a BCP 47 tag such as "pt-BR", or nil if the
+// alias is used everywhere.
+type Alias struct {
+	Name   string  `json:"name"`
+	Locale *string `json:"locale"`
+}
+
+// String renders the alias as "name" or "name (locale)", which is how it appears in CSV exports.
+func (a Alias) String() string {
+	if a.Locale == nil {
+		return a.Name
+	}
+	return a.Name + " (" + *a.Locale + ")"
+}
+
+// DuplicateCandidate is a pair of people who may be the same person. Score is a weighted blend
+// of name similarity, date of birth closeness and nationality, between 0 and 1.
+type DuplicateCandidate struct {
+	PersonID         string  `json:"personId"`
+	PersonName       string  `json:"personName"`
+	DuplicateID      string  `json:"duplicateId"`
+	DuplicateName    string  `json:"duplicateName"`
+	Score            float64 `json:"score"`
+	NameSimilarity   float64 `json:"nameSimilarity"`
+	DateOfBirthScore float64 `json:"dateOfBirthScore"`
+	SameNationality  bool    `json:"sameNationality"`
 }
@@ -0,0 +1,41 @@
+package persons
+
+import (
+	"github.com/rchauhan9/sportech/auth"
+	"github.com/rchauhan9/sportech/openapi"
+	"net/http"
+)
+
+var Routes = []openapi.Route{
+	{
+		Method:   http.MethodGet,
+		Path:     "/persons/{id}",
+		Tag:      "persons",
+		Summary:  "Get a person",
+		Response: getPersonResponse{},
+	},
+	{
+		Method:  http.MethodGet,
+		Path:    "/persons/duplicates",
+		Tag:     "persons",
+		Summary: "List pairs of people who may be the same person",
+		Query: []openapi.Parameter{
+			openapi.QueryParam("minScore", "number", "Only return pairs scoring at least this, between 0 and 1. Defaults to 0.6."),
+			openapi.QueryParam("limit", "integer", "The most pairs to return, at most 1000. Defaults to 100."),
+			openapi.FormatParam,
+		},
+		Response: listDuplicatesResponse{},
+		Formats:  true,
+		Scope:    auth.ScopeAdmin,
+	},
+	{
+		Method:      http.MethodPost,
+		Path:        "/persons/{id}/merge",
+		Tag:         "persons",
+		Summary:     "Merge duplicate people into this one",
+		Description: "The duplicates' ids keep working and resolve to the surviving person.",
+		Request:     mergePersonsRequest{},
+		Response:    mergePersonsResponse{},
+		Scope:       auth.ScopeAdmin,
+	},
+}
@@ -2,13 +2,23 @@ package persons
 
 import (
 	"context"
+	"fmt"
+	"github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/pgxpool"
 	"github.com/pkg/errors"
+	"github.com/rchauhan9/sportech/database"
+	"time"
 )
 
+// Tables lists the tables persons, their aliases and their redirects are read from.
+var Tables = []string{"persons", "person_aliases", "person_redirects"}
+
 type Repository interface {
 	ListPersons(ctx context.Context) ([]Person, error)
 	GetPerson(ctx context.Context, id string) (Person, error)
+	GetPersons(ctx context.Context, ids []string) (map[string]Person, error)
+	ListDuplicateCandidates(ctx context.Context, minScore float64, limit int) ([]DuplicateCandidate, error)
+	MergePersons(ctx context.Context, survivorID string, duplicateIDs []string) error
 }
 
 func NewRepository(dbPool *pgxpool.Pool) Repository {
@@ -22,12 +32,19 @@ type repository struct {
 func (r *repository) ListPersons(ctx context.Context) ([]Person, error) {
 	query := `
 		SELECT
-		    id,
-		    first_name,
-		    last_name,
-		    date_of_birth,
-		    country_id
-	    FROM persons
+		    p.id,
+		    p.first_name,
+		    p.middle_names,
+		    p.last_name,
+		    p.known_as,
+		    p.date_of_birth,
+		    p.country_id,
+		    COALESCE((
+		        SELECT json_agg(json_build_object('name', a.alias, 'locale', a.locale) ORDER BY a.locale NULLS FIRST, a.alias)
+		        FROM person_aliases a
+		        WHERE a.person_id = p.id
+		    ), '[]')
+	    FROM persons p
 	`
 	rows, err := r.pool.Query(ctx, query)
 	if err != nil {
@@ -40,9 +57,12 @@ func (r *repository) ListPersons(ctx context.Context) ([]Person, error) {
 		if err := rows.Scan(
 			&person.ID,
 			&person.FirstName,
+			&person.MiddleNames,
 			&person.LastName,
+			&person.KnownAs,
 			&person.DateOfBirth,
 			&person.Nationality,
+			&person.Aliases,
 		); err != nil {
 			return nil, errors.Wrap(err, "error scanning row from database")
 		}
@@ -51,26 +71,320 @@ func (r *repository) ListPersons(ctx context.Context) ([]Person, error) {
 	return persons, nil
 }
 
+// GetPerson follows merge redirects, so the ID of a person merged into another returns the survivor.
 func (r *repository) GetPerson(ctx context.Context, id string) (Person, error) {
 	query := `
 	    SELECT
-		    id,
-		    first_name,
-		    last_name,
-		    date_of_birth,
-		    country_id
-	    FROM persons
+		    p.id,
+		    p.first_name,
+		    p.middle_names,
+		    p.last_name,
+		    p.known_as,
+		    p.date_of_birth,
+		    p.country_id,
+		    COALESCE((
+		        SELECT json_agg(json_build_object('name', a.alias, 'locale', a.locale) ORDER BY a.locale NULLS FIRST, a.alias)
+		        FROM person_aliases a
+		        WHERE a.person_id = p.id
+		    ), '[]')
+	    FROM persons p
+	    WHERE p.id = COALESCE((SELECT new_person_id FROM person_redirects WHERE old_person_id = $1), $1)
 	`
 	row := r.pool.QueryRow(ctx, query, id)
 	var person Person
 	if err := row.Scan(
 		&person.ID,
 		&person.FirstName,
+		&person.MiddleNames,
 		&person.LastName,
+		&person.KnownAs,
 		&person.DateOfBirth,
 		&person.Nationality,
+		&person.Aliases,
 	); err != nil {
 		return person, errors.Wrapf(err, "error getting person with id %s", id)
 	}
 	return person, nil
 }
+
+// GetPersons looks up many people in one query, keyed by the IDs asked for. Like GetPerson it
+// follows merge redirects; IDs that match nobody are left out.
+func (r *repository) GetPersons(ctx context.Context, ids []string) (map[string]Person, error) {
+	query := `
+	    SELECT
+		    r.requested_id,
+		    p.id,
+		    p.first_name,
+		    p.middle_names,
+		    p.last_name,
+		    p.known_as,
+		    p.date_of_birth,
+		    p.country_id,
+		    COALESCE((
+		        SELECT json_agg(json_build_object('name', a.alias, 'locale', a.locale) ORDER BY a.locale NULLS FIRST, a.alias)
+		        FROM person_aliases a
+		        WHERE a.person_id = p.id
+		    ), '[]')
+	    FROM unnest($1::UUID[]) AS r(requested_id)
+	    LEFT JOIN person_redirects pr ON pr.old_person_id = r.requested_id
+	    JOIN persons p ON p.id = COALESCE(pr.new_person_id, r.requested_id)
+	`
+	rows, err := r.pool.Query(ctx, query, ids)
+	if err != nil {
+		return nil, errors.Wrap(err, "error fetching persons from database")
+	}
+	defer rows.Close()
+
+	persons := make(map[string]Person, len(ids))
+	for rows.Next() {
+		var requestedID string
+		person := Person{}
+		if err := rows.Scan(
+			&requestedID,
+			&person.ID,
+			&person.FirstName,
+			&person.MiddleNames,
+			&person.LastName,
+			&person.KnownAs,
+			&person.DateOfBirth,
+			&person.Nationality,
+			&person.Aliases,
+		); err != nil {
+			return nil, errors.Wrap(err, "error scanning row from database")
+		}
+		persons[requestedID] = person
+	}
+	return persons, rows.Err()
+}
+
+// Columns selects a person's fields for a query that joins them in with Join. Scan them with a
+// Scanner.
+const Columns = `
+		    p.id,
+		    p.first_name,
+		    p.middle_names,
+		    p.last_name,
+		    p.known_as,
+		    p.date_of_birth,
+		    p.country_id,
+		    COALESCE((
+		        SELECT json_agg(json_build_object('name', a.alias, 'locale', a.locale) ORDER BY a.locale NULLS FIRST, a.alias)
+		        FROM person_aliases a
+		        WHERE a.person_id = p.id
+		    ), '[]')`
+
+// Join left joins the person that column refers to as p, following merge redirects, so that
+// other repositories can read people in the same query as their own rows.
+func Join(column string) string {
+	return fmt.Sprintf(`
+	    LEFT JOIN person_redirects pr ON pr.old_person_id = %[1]s
+	    LEFT JOIN persons p ON p.id = COALESCE(pr.new_person_id, %[1]s)`, column)
+}
+
+// Scanner reads the Columns of a person who may be missing from the join.
+type Scanner struct {
+	id          *string
+	firstName   *string
+	middleNames *string
+	lastName    *string
+	knownAs     *string
+	dateOfBirth *time.Time
+	nationality *string
+	aliases     []Alias
+}
+
+// Targets returns the destinations to pass to Scan, in the order of Columns.
+func (s *Scanner) Targets() []interface{} {
+	return []interface{}{&s.id, &s.firstName, &s.middleNames, &s.lastName, &s.knownAs, &s.dateOfBirth, &s.nationality, &s.aliases}
+}
+
+// Person returns the person scanned, or the zero Person if nobody matched.
+func (s *Scanner) Person() Person {
+	if s.id == nil {
+		return Person{}
+	}
+	return Person{
+		ID:          *s.id,
+		FirstName:   *s.firstName,
+		MiddleNames: s.middleNames,
+		LastName:    *s.lastName,
+		KnownAs:     s.knownAs,
+		DateOfBirth: *s.dateOfBirth,
+		Nationality: *s.nationality,
+		Aliases:     s.aliases,
+	}
+}
+
+// ListDuplicateCandidates pairs up people whose names are trigram-similar, then scores each pair:
+// 60% name similarity, 30% date of birth (exact, within a week, or day and month swapped) and 10%
+// nationality.
+func (r *repository) ListDuplicateCandidates(ctx context.Context, minScore float64, limit int) ([]DuplicateCandidate, error) {
+	query := `
+	    SELECT person_id, person_name, duplicate_id, duplicate_name, score, name_similarity, dob_score, same_nationality
+	    FROM (
+	        SELECT
+	            a.id AS person_id,
+	            a.first_name || ' ' || a.last_name AS person_name,
+	            b.id AS duplicate_id,
+	            b.first_name || ' ' || b.last_name AS duplicate_name,
+	            0.6 * s.name_similarity + 0.3 * s.dob_score + CASE WHEN a.country_id = b.country_id THEN 0.1 ELSE 0 END AS score,
+	            s.name_similarity,
+	            s.dob_score,
+	            a.country_id = b.country_id AS same_nationality
+	        FROM persons a
+	        JOIN persons b
+	          ON a.id < b.id
+	         AND f_unaccent(lower(a.first_name || ' ' || coalesce(a.middle_names || ' ', '') || a.last_name))
+	           % f_unaccent(lower(b.first_name || ' ' || coalesce(b.middle_names || ' ', '') || b.last_name))
+	        CROSS JOIN LATERAL (
+	            SELECT
+	                similarity(
+	                    f_unaccent(lower(a.first_name || ' ' || coalesce(a.middle_names || ' ', '') || a.last_name)),
+	                    f_unaccent(lower(b.first_name || ' ' || coalesce(b.middle_names || ' ', '') || b.last_name))
+	                )::FLOAT8 AS name_similarity,
+	                CASE
+	                    WHEN a.date_of_birth = b.date_of_birth THEN 1.0
+	                    WHEN abs(a.date_of_birth - b.date_of_birth) <= 7 THEN 0.5
+	                    WHEN extract(year FROM a.date_of_birth) = extract(year FROM b.date_of_birth)
+	                     AND extract(month FROM a.date_of_birth) = extract(day FROM b.date_of_birth)
+	                     AND extract(day FROM a.date_of_birth) = extract(month FROM b.date_of_birth) THEN 0.5
+	                    ELSE 0.0
+	                END::FLOAT8 AS dob_score
+	        ) s
+	    ) candidates
+	    WHERE score >= $1
+	    ORDER BY score DESC, person_name ASC
+	    LIMIT $2
+	`
+	rows, err := r.pool.Query(ctx, query, minScore, limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "error fetching duplicate person candidates from database")
+	}
+	defer rows.Close()
+
+	var candidates []DuplicateCandidate
+	for rows.Next() {
+		candidate := DuplicateCandidate{}
+		if err := rows.Scan(
+			&candidate.PersonID,
+			&candidate.PersonName,
+			&candidate.DuplicateID,
+			&candidate.DuplicateName,
+			&candidate.Score,
+			&candidate.NameSimilarity,
+			&candidate.DateOfBirthScore,
+			&candidate.SameNationality,
+		); err != nil {
+			return nil, errors.Wrap(err, "error scanning row from database")
+		}
+		candidates = append(candidates, candidate)
+	}
+	return candidates, rows.Err()
+}
+
+// MergePersons folds duplicateIDs into survivorID in one transaction. Squad and manager spells,
+// referee records and aliases are repointed at the survivor, the duplicates' names are kept as
+// aliases, and each duplicate ID is recorded as a redirect before the duplicate is deleted.
+func (r *repository) MergePersons(ctx context.Context, survivorID string, duplicateIDs []string) error {
+	return r.pool.BeginFunc(ctx, func(tx pgx.Tx) error {
+		if err := database.LockTableVersions(ctx, tx,
+			"team_players", "team_managers", "referees", "match_officials", "person_aliases", "person_redirects", "persons",
+		); err != nil {
+			return err
+		}
+		// Lock the people being merged, in a fixed order, so that concurrent merges of any of them
+		// wait for this one rather than interleaving with it.
+		var found int
+		if err := tx.QueryRow(ctx, `
+		    SELECT count(*) FROM (
+		        SELECT id FROM persons WHERE id = $1 OR id = ANY($2::UUID[]) ORDER BY id FOR UPDATE
+		    ) locked
+		`, survivorID, duplicateIDs).Scan(&found); err != nil {
+			return errors.Wrap(err, "error checking persons to merge")
+		}
+		if found != len(duplicateIDs)+1 {
+			return errors.Wrapf(ErrInvalidArgument, "persons to merge into %s do not all exist", survivorID)
+		}
+
+		statements := []struct {
+			description string
+			query       string
+			args        []interface{}
+		}{
+			{"error removing duplicate player spells", `
+			    -- Keep one spell per team and start date among everyone being merged, preferring
+			    -- the survivor's own.
+			    DELETE FROM team_players d
+			    USING (
+			        SELECT id, ROW_NUMBER() OVER (PARTITION BY team_id, started ORDER BY person_id = $1 DESC, id) AS n
+			        FROM team_players
+			        WHERE person_id = $1 OR person_id = ANY($2::UUID[])
+			    ) ranked
+			    WHERE d.id = ranked.id AND ranked.n > 1
+			`, []interface{}{survivorID, duplicateIDs}},
+			{"error repointing player spells", `
+			    UPDATE team_players SET person_id = $1 WHERE person_id = ANY($2::UUID[])
+			`, []interface{}{survivorID, duplicateIDs}},
+			{"error removing duplicate manager spells", `
+			    -- Keep one spell per team and start date among everyone being merged, preferring
+			    -- the survivor's own.
+			    DELETE FROM team_managers d
+			    USING (
+			        SELECT id, ROW_NUMBER() OVER (PARTITION BY team_id, started ORDER BY person_id = $1 DESC, id) AS n
+			        FROM team_managers
+			        WHERE person_id = $1 OR person_id = ANY($2::UUID[])
+			    ) ranked
+			    WHERE d.id = ranked.id AND ranked.n > 1
+			`, []interface{}{survivorID, duplicateIDs}},
+			{"error repointing manager spells", `
+			    UPDATE team_managers SET person_id = $1 WHERE person_id = ANY($2::UUID[])
+			`, []interface{}{survivorID, duplicateIDs}},
+			{"error repointing referee", `
+			    UPDATE referees SET person_id = $1
+			    WHERE id = (SELECT id FROM referees WHERE person_id = ANY($2::UUID[]) ORDER BY started LIMIT 1)
+			      AND NOT EXISTS (SELECT 1 FROM referees WHERE person_id = $1)
+			`, []interface{}{survivorID, duplicateIDs}},
+			{"error repointing match officials", `
+			    UPDATE match_officials SET referee_id = (SELECT id FROM referees WHERE person_id = $1)
+			    WHERE referee_id IN (SELECT id FROM referees WHERE person_id = ANY($2::UUID[]))
+			`, []interface{}{survivorID, duplicateIDs}},
+			{"error removing duplicate referees", `
+			    DELETE FROM referees WHERE person_id = ANY($1::UUID[])
+			`, []interface{}{duplicateIDs}},
+			{"error keeping duplicate names as aliases", `
+			    INSERT INTO person_aliases (person_id, alias, locale)
+			    SELECT DISTINCT $1::UUID, n.alias, n.locale
+			    FROM (
+			        SELECT first_name || ' ' || last_name AS alias, NULL::TEXT AS locale FROM persons WHERE id = ANY($2::UUID[])
+			        UNION
+			        SELECT known_as, NULL FROM persons WHERE id = ANY($2::UUID[]) AND known_as IS NOT NULL
+			        UNION
+			        SELECT alias, locale FROM person_aliases WHERE person_id = ANY($2::UUID[])
+			    ) n
+			    WHERE NOT EXISTS (
+			        SELECT 1 FROM person_aliases a
+			        WHERE a.person_id = $1 AND a.alias = n.alias AND a.locale IS NOT DISTINCT FROM n.locale
+			    )
+			`, []interface{}{survivorID, duplicateIDs}},
+			{"error removing duplicate aliases", `
+			    DELETE FROM person_aliases WHERE person_id = ANY($1::UUID[])
+			`, []interface{}{duplicateIDs}},
+			{"error updating earlier redirects", `
+			    UPDATE person_redirects SET new_person_id = $1 WHERE new_person_id = ANY($2::UUID[])
+			`, []interface{}{survivorID, duplicateIDs}},
+			{"error recording redirects", `
+			    INSERT INTO person_redirects (old_person_id, new_person_id)
+			    SELECT unnest($2::UUID[]), $1::UUID
+			`, []interface{}{survivorID, duplicateIDs}},
+			{"error deleting duplicates", `
+			    DELETE FROM persons WHERE id = ANY($1::UUID[])
+			`, []interface{}{duplicateIDs}},
+		}
+		for _, statement := range statements {
+			if _, err := tx.Exec(ctx, statement.query, statement.args...); err != nil {
+				return errors.Wrapf(err, "%s while merging into person with id %s", statement.description, survivorID)
+			}
+		}
+		return nil
+	})
+}
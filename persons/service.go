@@ -2,11 +2,24 @@ package persons
 
 import (
 	"context"
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
 )
 
+const (
+	DefaultDuplicateMinScore = 0.6
+	DefaultDuplicateLimit    = 100
+	MaxDuplicateLimit        = 1000
+)
+
+var ErrInvalidArgument = errors.New("invalid argument")
+
 type Service interface {
 	ListPersons(ctx context.Context) ([]Person, error)
 	GetPerson(ctx context.Context, id string) (Person, error)
+	GetPersons(ctx context.Context, ids []string) (map[string]Person, error)
+	ListDuplicateCandidates(ctx context.Context, minScore float64, limit int) ([]DuplicateCandidate, error)
+	MergePersons(ctx context.Context, survivorID string, duplicateIDs []string) (Person, error)
 }
 
 func NewService(repository Repository) Service {
@@ -24,3 +37,40 @@ func (s *service) ListPersons(ctx context.Context) ([]Person, error) {
 func (s *service) GetPerson(ctx context.Context, id string) (Person, error) {
 	return s.repository.GetPerson(ctx, id)
 }
+
+func (s *service) GetPersons(ctx context.Context, ids []string) (map[string]Person, error) {
+	ids = lo.Uniq(ids)
+	if len(ids) == 0 {
+		return map[string]Person{}, nil
+	}
+	return s.repository.GetPersons(ctx, ids)
+}
+
+func (s *service) ListDuplicateCandidates(ctx context.Context, minScore float64, limit int) ([]DuplicateCandidate, error) {
+	if minScore < 0 || minScore > 1 {
+		return nil, errors.Wrapf(ErrInvalidArgument, "minScore %f must be between 0 and 1", minScore)
+	}
+	if limit <= 0 {
+		limit = DefaultDuplicateLimit
+	}
+	if limit > MaxDuplicateLimit {
+		return nil, errors.Wrapf(ErrInvalidArgument, "limit %d must be at most %d", limit, MaxDuplicateLimit)
+	}
+	return s.repository.ListDuplicateCandidates(ctx, minScore, limit)
+}
+
+func (s *service) MergePersons(ctx context.Context, survivorID string, duplicateIDs []string) (Person, error) {
+	duplicateIDs = lo.Uniq[string](duplicateIDs)
+	if len(duplicateIDs) == 0 {
+		return Person{}, errors.Wrap(ErrInvalidArgument, "no duplicates given to merge")
+	}
+	if lo.Contains[string](duplicateIDs, survivorID) {
+		return Person{}, errors.Wrapf(ErrInvalidArgument, "person with id %s cannot be merged into itself", survivorID)
+	}
+
+	if err := s.repository.MergePersons(ctx, survivorID, duplicateIDs); err != nil {
+		return Person{}, err
+	}
+
+	return s.repository.GetPerson(ctx, survivorID)
+}
@@ -3,12 +3,16 @@ package players
 import (
 	"context"
 	"github.com/go-kit/kit/endpoint"
+	"github.com/rchauhan9/sportech/stream"
 )
 
-type listPlayersRequest struct{}
+type listPlayersRequest struct {
+	Format string
+}
 
 type listPlayersResponse struct {
-	Players []Player `json:"players"`
+	Players stream.Stream[Player] `json:"players"`
+	format  string
 }
 
 type getPlayerRequest struct {
@@ -21,9 +25,8 @@ type getPlayerResponse struct {
 
 func MakeListPlayersEndpoint(svc Service) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
-		_ = request.(listPlayersRequest)
-		players, err := svc.ListPlayers(ctx)
-		return listPlayersResponse{Players: players}, err
+		req := request.(listPlayersRequest)
+		return listPlayersResponse{Players: svc.StreamPlayers, format: req.Format}, nil
 	}
 }
 
@@ -0,0 +1,111 @@
+package players
+
+import (
+	"context"
+	"github.com/go-kit/kit/endpoint"
+	grpctransport "github.com/go-kit/kit/transport/grpc"
+	"github.com/pkg/errors"
+	"github.com/rchauhan9/sportech/auth"
+	"github.com/rchauhan9/sportech/commons/go/grpcutil"
+	pb "github.com/rchauhan9/sportech/pb/sportech/v1"
+	"github.com/rchauhan9/sportech/persons"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type grpcServer struct {
+	pb.UnimplementedPlayerServiceServer
+	listPlayers grpctransport.Handler
+	getPlayer   grpctransport.Handler
+}
+
+func MakeGRPCServer(listPlayersEndpoint endpoint.Endpoint, getPlayerEndpoint endpoint.Endpoint) pb.PlayerServiceServer {
+	return &grpcServer{
+		listPlayers: grpctransport.NewServer(
+			listPlayersEndpoint,
+			decodeGRPCListPlayersRequest,
+			encodeGRPCListPlayersResponse,
+		),
+		getPlayer: grpctransport.NewServer(
+			getPlayerEndpoint,
+			decodeGRPCGetPlayerRequest,
+			encodeGRPCGetPlayerResponse,
+		),
+	}
+}
+
+func (s *grpcServer) ListPlayers(ctx context.Context, req *pb.ListPlayersRequest) (*pb.ListPlayersResponse, error) {
+	_, rep, err := s.listPlayers.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeGRPCError(err)
+	}
+	return rep.(*pb.ListPlayersResponse), nil
+}
+
+func (s *grpcServer) GetPlayer(ctx context.Context, req *pb.GetPlayerRequest) (*pb.GetPlayerResponse, error) {
+	_, rep, err := s.getPlayer.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeGRPCError(err)
+	}
+	return rep.(*pb.GetPlayerResponse), nil
+}
+
+func decodeGRPCListPlayersRequest(_ context.Context, _ interface{}) (interface{}, error) {
+	return listPlayersRequest{}, nil
+}
+
+func encodeGRPCListPlayersResponse(ctx context.Context, response interface{}) (interface{}, error) {
+	resp := response.(listPlayersResponse)
+	var players []*pb.Player
+	if err := resp.Players(ctx, func(player Player) error {
+		players = append(players, encodeGRPCPlayer(player))
+		return nil
+	}); err != nil {
+		return nil, encodeGRPCError(err)
+	}
+	return &pb.ListPlayersResponse{Players: players}, nil
+}
+
+func decodeGRPCGetPlayerRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.GetPlayerRequest)
+	return getPlayerRequest{ID: req.Id}, nil
+}
+
+func encodeGRPCGetPlayerResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(getPlayerResponse)
+	return &pb.GetPlayerResponse{Player: encodeGRPCPlayer(resp.Player)}, nil
+}
+
+func encodeGRPCPlayer(player Player) *pb.Player {
+	return &pb.Player{
+		Id:               player.ID,
+		FirstName:        player.FirstName,
+		MiddleNames:      player.MiddleNames,
+		LastName:         player.LastName,
+		KnownAs:          player.KnownAs,
+		DateOfBirth:      timestamppb.New(player.DateOfBirth),
+		Nationality:      player.Nationality,
+		Aliases:          persons.EncodeGRPCAliases(player.Aliases),
+		Team:             player.Team,
+		SquadNumber:      player.SquadNumber,
+		GeneralPosition:  player.GeneralPosition,
+		SpecificPosition: player.SpecificPosition,
+		Started:          timestamppb.New(player.Started),
+		Ended:            grpcutil.OptionalTimestamp(player.Ended),
+	}
+}
+
+// encode errors from business-logic as gRPC statuses
+func encodeGRPCError(err error) error {
+	switch errors.Cause(err) {
+	case ErrInvalidArgument:
+		return status.Error(codes.InvalidArgument, err.Error())
+	case auth.ErrUnauthenticated:
+		return status.Error(codes.Unauthenticated, err.Error())
+	case auth.ErrForbidden:
+		return status.Error(codes.PermissionDenied, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
@@ -7,6 +7,10 @@ import (
 	kithttp "github.com/go-kit/kit/transport/http"
 	"github.com/gorilla/mux"
 	"github.com/pkg/errors"
+	"github.com/rchauhan9/sportech/auth"
+	"github.com/rchauhan9/sportech/commons/go/logging"
+	"github.com/rchauhan9/sportech/commons/go/queryutil"
+	"github.com/rchauhan9/sportech/stream"
 	"net/http"
 )
 
@@ -17,12 +21,14 @@ func MakeHandler(listPlayersEndpoint endpoint.Endpoint, getPlayerEndpoint endpoi
 		listPlayersEndpoint,
 		decodeListPlayersRequest,
 		encodeListPlayersResponse,
+		kithttp.ServerErrorEncoder(encodeError),
 	)
 
 	getPlayerHandler := kithttp.NewServer(
 		getPlayerEndpoint,
 		decodeGetPlayerRequest,
 		encodeGetPlayerResponse,
+		kithttp.ServerErrorEncoder(encodeError),
 	)
 
 	r.Handle("/players/{id}", getPlayerHandler).Methods("GET")
@@ -32,8 +38,11 @@ func MakeHandler(listPlayersEndpoint endpoint.Endpoint, getPlayerEndpoint endpoi
 }
 
 func decodeListPlayersRequest(_ context.Context, r *http.Request) (interface{}, error) {
-	_ = mux.Vars(r)
-	return listPlayersRequest{}, nil
+	format, err := queryutil.Format(r, ErrInvalidArgument)
+	if err != nil {
+		return nil, err
+	}
+	return listPlayersRequest{Format: format}, nil
 }
 
 func encodeListPlayersResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
@@ -41,7 +50,8 @@ func encodeListPlayersResponse(ctx context.Context, w http.ResponseWriter, respo
 		encodeError(ctx, e.error(), w)
 		return nil
 	}
-	return json.NewEncoder(w).Encode(response)
+	resp := response.(listPlayersResponse)
+	return stream.Encode(ctx, w, resp.format, "players", resp.Players)
 }
 
 func decodeGetPlayerRequest(_ context.Context, r *http.Request) (interface{}, error) {
@@ -66,13 +76,16 @@ type errorer interface {
 }
 
 // encode errors from business-logic
-func encodeError(_ context.Context, err error, w http.ResponseWriter) {
+func encodeError(ctx context.Context, err error, w http.ResponseWriter) {
+	logging.RecordError(ctx, err)
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
-	switch err {
-	//case erro:
-	//	w.WriteHeader(http.StatusNotFound)
-	//case ErrInvalidArgument:
-	//	w.WriteHeader(http.StatusBadRequest)
+	switch errors.Cause(err) {
+	case ErrInvalidArgument:
+		w.WriteHeader(http.StatusBadRequest)
+	case auth.ErrUnauthenticated:
+		w.WriteHeader(http.StatusUnauthorized)
+	case auth.ErrForbidden:
+		w.WriteHeader(http.StatusForbidden)
 	default:
 		w.WriteHeader(http.StatusInternalServerError)
 	}
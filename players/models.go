@@ -1,20 +1,25 @@
 package players
 
-import "time"
+import (
+	"github.com/rchauhan9/sportech/persons"
+	"time"
+)
 
 type Player struct {
-	ID               string     `json:"id"`
-	FirstName        string     `json:"firstName"`
-	MiddleNames      *string    `json:"middleNames"`
-	LastName         string     `json:"lastName"`
-	DateOfBirth      time.Time  `json:"dateOfBirth"`
-	Nationality      string     `json:"nationality"`
-	Team             string     `json:"team"`
-	SquadNumber      int32      `json:"squadNumber"`
-	GeneralPosition  string     `json:"generalPosition"`
-	SpecificPosition *string    `json:"specificPosition"`
-	Started          time.Time  `json:"started"`
-	Ended            *time.Time `json:"ended"`
+	ID               string          `json:"id"`
+	FirstName        string          `json:"firstName"`
+	MiddleNames      *string         `json:"middleNames"`
+	LastName         string          `json:"lastName"`
+	KnownAs          *string         `json:"knownAs"`
+	DateOfBirth      time.Time       `json:"dateOfBirth"`
+	Nationality      string          `json:"nationality"`
+	Aliases          []persons.Alias `json:"aliases"`
+	Team             string          `json:"team"`
+	SquadNumber      int32           `json:"squadNumber"`
+	GeneralPosition  string          `json:"generalPosition"`
+	SpecificPosition *string         `json:"specificPosition"`
+	Started          time.Time       `json:"started"`
+	Ended            *time.Time      `json:"ended"`
 }
 
 type PlayerDB struct {
@@ -26,4 +31,6 @@ type PlayerDB struct {
 	SpecificPosition *string
 	Started          time.Time
 	Ended            *time.Time
+	// Person is only filled in by the list queries, not by GetPlayer.
+	Person persons.Person
 }
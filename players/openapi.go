@@ -0,0 +1,25 @@
+package players
+
+import (
+	"github.com/rchauhan9/sportech/openapi"
+	"net/http"
+)
+
+var Routes = []openapi.Route{
+	{
+		Method:   http.MethodGet,
+		Path:     "/players/",
+		Tag:      "players",
+		Summary:  "List players",
+		Query:    []openapi.Parameter{openapi.FormatParam},
+		Response: listPlayersResponse{},
+		Formats:  true,
+	},
+	{
+		Method:   http.MethodGet,
+		Path:     "/players/{id}",
+		Tag:      "players",
+		Summary:  "Get a player",
+		Response: getPlayerResponse{},
+	},
+}
@@ -2,12 +2,19 @@ package players
 
 import (
 	"context"
+	"fmt"
 	"github.com/jackc/pgx/v4/pgxpool"
 	"github.com/pkg/errors"
+	"github.com/rchauhan9/sportech/persons"
 )
 
+// Tables lists the tables players are read from, the persons tables included.
+var Tables = append([]string{"team_players"}, persons.Tables...)
+
 type Repository interface {
 	ListPlayers(ctx context.Context) ([]PlayerDB, error)
+	StreamPlayers(ctx context.Context, yield func(PlayerDB) error) error
+	ListPlayersInTeams(ctx context.Context, teamIDs []string) ([]PlayerDB, error)
 	GetPlayer(ctx context.Context, id string) (PlayerDB, error)
 }
 
@@ -19,29 +26,47 @@ type repository struct {
 	pool *pgxpool.Pool
 }
 
-func (r *repository) ListPlayers(ctx context.Context) ([]PlayerDB, error) {
-	query := `
+// StreamPlayers reads each player along with the person behind them, in one query.
+func (r *repository) StreamPlayers(ctx context.Context, yield func(PlayerDB) error) error {
+	return r.streamPlayers(ctx, "", nil, yield)
+}
+
+// ListPlayersInTeams reads the players of the given teams only, so relations can be resolved
+// without reading the whole table.
+func (r *repository) ListPlayersInTeams(ctx context.Context, teamIDs []string) ([]PlayerDB, error) {
+	var players []PlayerDB
+	err := r.streamPlayers(ctx, "WHERE t.team_id = ANY($1)", []interface{}{teamIDs}, func(player PlayerDB) error {
+		players = append(players, player)
+		return nil
+	})
+	return players, err
+}
+
+func (r *repository) streamPlayers(ctx context.Context, where string, args []interface{}, yield func(PlayerDB) error) error {
+	query := fmt.Sprintf(`
 		SELECT
-		    id,
-		    person_id,
-		    team_id,
-		    squad_number,
-		    general_position,
-		    specific_position,
-		    started,
-		    ended
-	    FROM team_players
-	    ORDER BY started ASC
-	`
-	rows, err := r.pool.Query(ctx, query)
+		    t.id,
+		    t.person_id,
+		    t.team_id,
+		    t.squad_number,
+		    t.general_position,
+		    t.specific_position,
+		    t.started,
+		    t.ended,%s
+	    FROM team_players t%s
+	    %s
+	    ORDER BY t.started ASC
+	`, persons.Columns, persons.Join("t.person_id"), where)
+	rows, err := r.pool.Query(ctx, query, args...)
 	if err != nil {
-		return nil, errors.Wrap(err, "error fetching discover category details from database")
+		return errors.Wrap(err, "error fetching discover category details from database")
 	}
 
-	var players []PlayerDB
+	defer rows.Close()
 	for rows.Next() {
 		player := PlayerDB{}
-		if err := rows.Scan(
+		person := persons.Scanner{}
+		if err := rows.Scan(append([]interface{}{
 			&player.ID,
 			&player.PersonID,
 			&player.TeamID,
@@ -50,12 +75,24 @@ func (r *repository) ListPlayers(ctx context.Context) ([]PlayerDB, error) {
 			&player.SpecificPosition,
 			&player.Started,
 			&player.Ended,
-		); err != nil {
-			return nil, errors.Wrap(err, "error scanning row from database")
+		}, person.Targets()...)...); err != nil {
+			return errors.Wrap(err, "error scanning row from database")
+		}
+		player.Person = person.Person()
+		if err := yield(player); err != nil {
+			return err
 		}
-		players = append(players, player)
 	}
-	return players, nil
+	return rows.Err()
+}
+
+func (r *repository) ListPlayers(ctx context.Context) ([]PlayerDB, error) {
+	var players []PlayerDB
+	err := r.StreamPlayers(ctx, func(player PlayerDB) error {
+		players = append(players, player)
+		return nil
+	})
+	return players, err
 }
 
 func (r *repository) GetPlayer(ctx context.Context, id string) (PlayerDB, error) {
@@ -64,11 +64,11 @@ func (suite *RepositoryTestSuite) TearDownSuite() {
 }
 
 func (suite *RepositoryTestSuite) SetupTest() {
-	suite.cleaner.Acquire("persons", "team_players")
+	suite.cleaner.Acquire("persons", "person_redirects", "team_players")
 }
 
 func (suite *RepositoryTestSuite) TearDownTest() {
-	suite.cleaner.Clean("persons", "team_players")
+	suite.cleaner.Clean("persons", "person_redirects", "team_players")
 }
 
 func TestRepositoryTestSuite(t *testing.T) {
@@ -97,6 +97,48 @@ func (suite *RepositoryTestSuite) TestListPlayers() {
 	}
 }
 
+func (suite *RepositoryTestSuite) TestStreamPlayersJoinsPersons() {
+	var salahID, mergedID string
+	row := suite.dbPool.QueryRow(suite.ctx, `
+	    INSERT INTO persons (first_name, last_name, date_of_birth, country_id)
+	    VALUES ('Mohamed', 'Salah', '1992-06-15', $1)
+	    RETURNING id
+	`, uuid.NewString())
+	require.NoError(suite.T(), row.Scan(&salahID))
+	mergedID = uuid.NewString()
+	_, err := suite.dbPool.Exec(suite.ctx, `INSERT INTO person_redirects (old_person_id, new_person_id) VALUES ($1, $2)`, mergedID, salahID)
+	require.NoError(suite.T(), err)
+
+	redirected := createTeamPlayer(suite, mergedID, uuid.NewString(), 11, "FWD", "RW", time.Date(2017, time.July, 1, 0, 0, 0, 0, time.UTC), nil)
+	missing := createTeamPlayer(suite, uuid.NewString(), uuid.NewString(), 1, "GK", "GK", time.Date(2018, time.July, 1, 0, 0, 0, 0, time.UTC), nil)
+
+	var playerDBs []players.PlayerDB
+	err = suite.repository.StreamPlayers(suite.ctx, func(player players.PlayerDB) error {
+		playerDBs = append(playerDBs, player)
+		return nil
+	})
+	require.NoError(suite.T(), err)
+
+	require.Equal(suite.T(), 2, len(playerDBs))
+	require.Equal(suite.T(), redirected.ID, playerDBs[0].ID)
+	require.Equal(suite.T(), salahID, playerDBs[0].Person.ID)
+	require.Equal(suite.T(), "Salah", playerDBs[0].Person.LastName)
+	require.Equal(suite.T(), missing.ID, playerDBs[1].ID)
+	require.Equal(suite.T(), "", playerDBs[1].Person.ID)
+}
+
+func (suite *RepositoryTestSuite) TestListPlayersInTeams() {
+	liverpoolID, evertonID := uuid.NewString(), uuid.NewString()
+	salah := createTeamPlayer(suite, uuid.NewString(), liverpoolID, 11, "FWD", "RW", time.Date(2017, time.July, 1, 0, 0, 0, 0, time.UTC), nil)
+	createTeamPlayer(suite, uuid.NewString(), evertonID, 9, "FWD", "ST", time.Date(2019, time.July, 1, 0, 0, 0, 0, time.UTC), nil)
+
+	result, err := suite.repository.ListPlayersInTeams(suite.ctx, []string{liverpoolID})
+	require.NoError(suite.T(), err)
+
+	require.Equal(suite.T(), 1, len(result))
+	require.Equal(suite.T(), salah.ID, result[0].ID)
+}
+
 func (suite *RepositoryTestSuite) TestGetPlayer() {
 	expected := createTeamPlayer(suite, uuid.New().String(), uuid.New().String(), 11, "FWD", "RW", time.Date(2016, time.July, 1, 0, 0, 0, 0, time.UTC), nil)
 
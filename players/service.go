@@ -4,11 +4,15 @@ import (
 	"context"
 	"github.com/pkg/errors"
 	"github.com/rchauhan9/sportech/persons"
-	"github.com/samber/lo"
+	"github.com/rchauhan9/sportech/stream"
 )
 
+var ErrInvalidArgument = errors.New("invalid argument")
+
 type Service interface {
 	ListPlayers(ctx context.Context) ([]Player, error)
+	StreamPlayers(ctx context.Context, yield func(Player) error) error
+	ListPlayersInTeams(ctx context.Context, teamIDs []string) (map[string][]Player, error)
 	GetPlayer(ctx context.Context, id string) (Player, error)
 }
 
@@ -22,36 +26,28 @@ type service struct {
 }
 
 func (s *service) ListPlayers(ctx context.Context) ([]Player, error) {
-	playersDB, err := s.repository.ListPlayers(ctx)
-	if err != nil {
-		return nil, err
-	}
-
-	people, err := s.personsService.ListPersons(ctx)
+	return stream.Stream[Player](s.StreamPlayers).Collect(ctx)
+}
 
-	personsMap := lo.KeyBy[string, persons.Person](people, func(person persons.Person) string {
-		return person.ID
+// StreamPlayers relies on the repository joining in each player's person, so that a list holds
+// a single connection for as long as it streams.
+func (s *service) StreamPlayers(ctx context.Context, yield func(Player) error) error {
+	return s.repository.StreamPlayers(ctx, func(player PlayerDB) error {
+		return yield(newPlayer(player, player.Person))
 	})
+}
 
-	players := make([]Player, len(playersDB))
-	for i := range playersDB {
-		players[i] = Player{
-			ID:               playersDB[i].ID,
-			FirstName:        personsMap[playersDB[i].PersonID].FirstName,
-			MiddleNames:      personsMap[playersDB[i].PersonID].MiddleNames,
-			LastName:         personsMap[playersDB[i].PersonID].LastName,
-			DateOfBirth:      personsMap[playersDB[i].PersonID].DateOfBirth,
-			Nationality:      personsMap[playersDB[i].PersonID].Nationality,
-			Team:             playersDB[i].TeamID,
-			SquadNumber:      playersDB[i].SquadNumber,
-			GeneralPosition:  playersDB[i].GeneralPosition,
-			SpecificPosition: playersDB[i].SpecificPosition,
-			Started:          playersDB[i].Started,
-			Ended:            playersDB[i].Ended,
-		}
+// ListPlayersInTeams groups the players of the given teams by team ID. Teams without players are left out.
+func (s *service) ListPlayersInTeams(ctx context.Context, teamIDs []string) (map[string][]Player, error) {
+	players, err := s.repository.ListPlayersInTeams(ctx, teamIDs)
+	if err != nil {
+		return nil, err
 	}
-
-	return players, nil
+	byTeam := make(map[string][]Player, len(teamIDs))
+	for _, player := range players {
+		byTeam[player.TeamID] = append(byTeam[player.TeamID], newPlayer(player, player.Person))
+	}
+	return byTeam, nil
 }
 
 func (s *service) GetPlayer(ctx context.Context, id string) (Player, error) {
@@ -65,18 +61,24 @@ func (s *service) GetPlayer(ctx context.Context, id string) (Player, error) {
 		return Player{}, errors.Wrapf(err, "error getting player with id %s", id)
 	}
 
+	return newPlayer(player, person), nil
+}
+
+func newPlayer(db PlayerDB, person persons.Person) Player {
 	return Player{
-		ID:               player.ID,
+		ID:               db.ID,
 		FirstName:        person.FirstName,
 		MiddleNames:      person.MiddleNames,
 		LastName:         person.LastName,
+		KnownAs:          person.KnownAs,
 		DateOfBirth:      person.DateOfBirth,
 		Nationality:      person.Nationality,
-		Team:             player.TeamID,
-		SquadNumber:      player.SquadNumber,
-		GeneralPosition:  player.GeneralPosition,
-		SpecificPosition: player.SpecificPosition,
-		Started:          player.Started,
-		Ended:            player.Ended,
-	}, nil
+		Aliases:          person.Aliases,
+		Team:             db.TeamID,
+		SquadNumber:      db.SquadNumber,
+		GeneralPosition:  db.GeneralPosition,
+		SpecificPosition: db.SpecificPosition,
+		Started:          db.Started,
+		Ended:            db.Ended,
+	}
 }
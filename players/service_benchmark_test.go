@@ -0,0 +1,81 @@
+package players_test
+
+import (
+	"context"
+	"github.com/go-kit/log"
+	"github.com/rchauhan9/sportech/database"
+	"github.com/rchauhan9/sportech/persons"
+	"github.com/rchauhan9/sportech/players"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+const (
+	benchmarkPersons = 100000
+	benchmarkPlayers = 500
+)
+
+// BenchmarkListPlayersFullTable is how ListPlayers used to enrich players: load every person and
+// key them by ID.
+func BenchmarkListPlayersFullTable(b *testing.B) {
+	ctx, repository, personsService := setupBenchmark(b)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		playersDB, err := repository.ListPlayers(ctx)
+		require.NoError(b, err)
+		people, err := personsService.ListPersons(ctx)
+		require.NoError(b, err)
+		personsMap := lo.KeyBy[string, persons.Person](people, func(person persons.Person) string {
+			return person.ID
+		})
+		for _, player := range playersDB {
+			_ = personsMap[player.PersonID]
+		}
+	}
+}
+
+func BenchmarkListPlayers(b *testing.B) {
+	ctx, repository, personsService := setupBenchmark(b)
+	service := players.NewService(repository, personsService)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		result, err := service.ListPlayers(ctx)
+		require.NoError(b, err)
+		require.Equal(b, benchmarkPlayers, len(result))
+	}
+}
+
+// setupBenchmark migrates a fresh database holding benchmarkPersons people, benchmarkPlayers of
+// whom play for a team.
+func setupBenchmark(b *testing.B) (context.Context, players.Repository, persons.Service) {
+	ctx := context.Background()
+
+	migrator, err := database.NewMigrator(postgresURL, migrationPath, log.NewNopLogger())
+	require.NoError(b, err)
+	b.Cleanup(func() { migrator.Close() })
+	require.NoError(b, migrator.PurgeDB())
+	require.NoError(b, migrator.MigrateDb())
+
+	dbPool, err := database.NewDatabasePool(ctx, postgresURL)
+	require.NoError(b, err)
+	b.Cleanup(dbPool.Close)
+
+	_, err = dbPool.Exec(ctx, `
+	    INSERT INTO persons (first_name, last_name, date_of_birth, country_id)
+	    SELECT 'First ' || i, 'Last ' || i, DATE '1970-01-01' + (i % 15000), uuid_generate_v4()
+	    FROM generate_series(1, $1::INT) AS i
+	`, benchmarkPersons)
+	require.NoError(b, err)
+
+	_, err = dbPool.Exec(ctx, `
+	    INSERT INTO team_players (person_id, team_id, squad_number, general_position, specific_position, started)
+	    SELECT id, uuid_generate_v4(), 1 + row_number() OVER () % 99, 'MID', 'CM', DATE '2020-07-01'
+	    FROM (SELECT id FROM persons ORDER BY random() LIMIT $1::INT) AS p
+	`, benchmarkPlayers)
+	require.NoError(b, err)
+
+	return ctx, players.NewRepository(dbPool), persons.NewService(persons.NewRepository(dbPool))
+}
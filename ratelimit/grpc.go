@@ -0,0 +1,61 @@
+package ratelimit
+
+import (
+	"context"
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+	"github.com/rchauhan9/sportech/auth"
+	"github.com/rchauhan9/sportech/commons/go/logging"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"strings"
+)
+
+// UnaryServerInterceptor limits gRPC calls as MakeHandler does HTTP requests, sending the same
+// headers as metadata and failing with ResourceExhausted once a client runs out. groups maps a
+// service such as "sportech.v1.TeamService" to the group its calls count against, so that a
+// client shares one limit across both transports. It must run after auth.UnaryServerInterceptor.
+func UnaryServerInterceptor(limiter Limiter, groups map[string]string, limit func(group string) Limit, authenticator auth.Authenticator, trustForwardedFor bool, logger log.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		service, _, _ := strings.Cut(strings.TrimPrefix(info.FullMethod, "/"), "/")
+		group, ok := groups[service]
+		if !ok {
+			return handler(ctx, req)
+		}
+		groupLimit := limit(group)
+		key := group + ":" + ClientKey(ctx, authenticator, grpcClientIP(ctx, trustForwardedFor))
+		decision, err := limiter.Allow(ctx, key, groupLimit)
+		if err != nil {
+			level.Error(logging.FromContext(ctx, logger)).Log("group", group, "err", err)
+			return handler(ctx, req)
+		}
+
+		md := metadata.MD{}
+		for name, value := range headers(groupLimit, decision) {
+			md.Set(name, value)
+		}
+		grpc.SetHeader(ctx, md)
+		if !decision.Allowed {
+			return nil, status.Error(codes.ResourceExhausted, "rate limit exceeded")
+		}
+		return handler(ctx, req)
+	}
+}
+
+// grpcClientIP is ClientIP for gRPC, reading the x-forwarded-for metadata a proxy would set.
+func grpcClientIP(ctx context.Context, trustForwardedFor bool) string {
+	if trustForwardedFor {
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if forwarded := md.Get("x-forwarded-for"); len(forwarded) > 0 {
+				return lastForwarded(forwarded[len(forwarded)-1])
+			}
+		}
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		return host(p.Addr.String())
+	}
+	return ""
+}
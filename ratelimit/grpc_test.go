@@ -0,0 +1,36 @@
+package ratelimit
+
+import (
+	"context"
+	"github.com/go-kit/log"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"net"
+	"testing"
+)
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	l, _ := newTestLimiter(&fakeRepository{usage: map[quotaDay]int64{}})
+	groups := map[string]string{"sportech.v1.PlayerService": "players"}
+	limit := func(string) Limit { return Limit{Rate: 1, Burst: 1} }
+	interceptor := UnaryServerInterceptor(l, groups, limit, fakeAuthenticator{}, false, log.NewNopLogger())
+
+	served := 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		served++
+		return nil, nil
+	}
+	call := func(method string) error {
+		ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("203.0.113.7"), Port: 1234}})
+		_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+		return err
+	}
+
+	require.NoError(t, call("/sportech.v1.PlayerService/ListPlayers"))
+	require.Equal(t, codes.ResourceExhausted, status.Code(call("/sportech.v1.PlayerService/GetPlayer")))
+	require.NoError(t, call("/grpc.reflection.v1alpha.ServerReflection/ServerReflectionInfo"))
+	require.Equal(t, 2, served)
+}
@@ -0,0 +1,110 @@
+package ratelimit
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+	"github.com/rchauhan9/sportech/auth"
+	"github.com/rchauhan9/sportech/commons/go/logging"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// MakeHandler wraps next so each client may only call it as often as limit allows, counted
+// separately for each group of routes. Clients are told where they stand in X-RateLimit-*
+// headers, and turned away with 429 Too Many Requests and a Retry-After header once they run
+// out. If the limiter fails, the request is let through.
+func MakeHandler(next http.Handler, limiter Limiter, group string, limit Limit, authenticator auth.Authenticator, trustForwardedFor bool, logger log.Logger) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := group + ":" + ClientKey(r.Context(), authenticator, ClientIP(r, trustForwardedFor))
+		decision, err := limiter.Allow(r.Context(), key, limit)
+		if err != nil {
+			level.Error(logging.FromContext(r.Context(), logger)).Log("group", group, "err", err)
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		header := w.Header()
+		for name, value := range headers(limit, decision) {
+			header.Set(name, value)
+		}
+
+		if !decision.Allowed {
+			header.Set("Content-Type", "application/json; charset=utf-8")
+			w.WriteHeader(http.StatusTooManyRequests)
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"error": "rate limit exceeded",
+			})
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// headers tells a client where they stand after decision, with Retry-After once they are turned
+// away.
+func headers(limit Limit, decision Decision) map[string]string {
+	h := map[string]string{
+		"X-RateLimit-Limit":     strconv.Itoa(limit.Burst),
+		"X-RateLimit-Remaining": strconv.Itoa(decision.Remaining),
+		"X-RateLimit-Reset":     ceilSeconds(decision.Reset),
+	}
+	if limit.DailyQuota > 0 {
+		h["X-RateLimit-Quota-Limit"] = strconv.FormatInt(limit.DailyQuota, 10)
+		h["X-RateLimit-Quota-Remaining"] = strconv.FormatInt(decision.QuotaRemaining, 10)
+		h["X-RateLimit-Quota-Reset"] = ceilSeconds(decision.QuotaReset)
+	}
+	if !decision.Allowed {
+		h["Retry-After"] = ceilSeconds(decision.RetryAfter)
+	}
+	return h
+}
+
+// ClientKey identifies who made a request: a hash of the subject their credential belongs to,
+// or ip if they sent no credential or one that authenticator does not accept. Keying on the
+// credential itself would let a client start afresh with every made-up key.
+func ClientKey(ctx context.Context, authenticator auth.Authenticator, ip string) string {
+	if credential := auth.CredentialFromContext(ctx); credential != "" {
+		if principal, err := authenticator.Authenticate(ctx, credential); err == nil {
+			sum := sha256.Sum256([]byte(principal.Subject))
+			return "principal:" + hex.EncodeToString(sum[:16])
+		}
+	}
+	return "ip:" + ip
+}
+
+// ClientIP is the address a request came from. With trustForwardedFor set, as it should be
+// behind a proxy, it is the last in X-Forwarded-For, which the proxy appended; earlier ones are
+// whatever the client sent.
+func ClientIP(r *http.Request, trustForwardedFor bool) string {
+	if trustForwardedFor {
+		if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
+			return lastForwarded(forwarded)
+		}
+	}
+	return host(r.RemoteAddr)
+}
+
+func lastForwarded(forwarded string) string {
+	addresses := strings.Split(forwarded, ",")
+	return strings.TrimSpace(addresses[len(addresses)-1])
+}
+
+func host(address string) string {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return address
+	}
+	return host
+}
+
+func ceilSeconds(d time.Duration) string {
+	return strconv.Itoa(int(math.Ceil(d.Seconds())))
+}
@@ -0,0 +1,351 @@
+package ratelimit
+
+import (
+	"context"
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+	"github.com/pkg/errors"
+	"math"
+	"sync"
+	"time"
+)
+
+// Limit is how fast one client may call a group of routes: Rate requests a second on average, in
+// bursts of up to Burst, and at most DailyQuota a day (UTC). A DailyQuota of zero is unlimited.
+type Limit struct {
+	Rate       float64
+	Burst      int
+	DailyQuota int64
+}
+
+func (l Limit) Validate() error {
+	if l.Rate <= 0 || l.Burst < 1 {
+		return errors.Errorf("rate limit needs a positive rate and a burst of at least 1, not %v and %d", l.Rate, l.Burst)
+	}
+	if l.DailyQuota < 0 {
+		return errors.Errorf("daily quota cannot be negative, not %d", l.DailyQuota)
+	}
+	return nil
+}
+
+// Bucket is what is left of a client's burst after a request, and whether it was let through.
+type Bucket struct {
+	Tokens  float64
+	Allowed bool
+}
+
+// Decision is whether a request may go ahead, with what is needed to tell the client where they
+// stand.
+type Decision struct {
+	Allowed bool
+	// Remaining is how many requests could be made straight away, and Reset how long until the
+	// whole burst is available again.
+	Remaining int
+	Reset     time.Duration
+	// QuotaRemaining is what is left of today's quota, and QuotaReset how long until it renews.
+	QuotaRemaining int64
+	QuotaReset     time.Duration
+	// RetryAfter is how long to wait before trying again, when the request is not allowed.
+	RetryAfter time.Duration
+}
+
+type Limiter interface {
+	// Allow takes a request by client from the bucket and quota for key.
+	Allow(ctx context.Context, key string, limit Limit) (Decision, error)
+	// Run does the limiter's housekeeping until ctx is cancelled.
+	Run(ctx context.Context)
+}
+
+// NewLocalLimiter keeps buckets in memory, so each instance limits clients on its own. Quota use
+// is still written to the database every few seconds, so it survives restarts. At most maxKeys
+// buckets are kept; past that, making room for a new client may hand another a full bucket early.
+func NewLocalLimiter(repository Repository, maxKeys int, logger log.Logger) Limiter {
+	return &localLimiter{
+		repository: repository,
+		logger:     logger,
+		maxKeys:    maxKeys,
+		buckets:    map[string]*localBucket{},
+		quotas:     map[quotaDay]*localQuota{},
+		now:        time.Now,
+	}
+}
+
+// evictionScan is how many entries are looked at for one that is safe to forget before giving up
+// and, for buckets, forgetting whichever was looked at last.
+const evictionScan = 8
+
+type localLimiter struct {
+	repository Repository
+	logger     log.Logger
+	maxKeys    int
+	mu         sync.Mutex
+	buckets    map[string]*localBucket
+	quotas     map[quotaDay]*localQuota
+	now        func() time.Time
+}
+
+type localBucket struct {
+	tokens  float64
+	updated time.Time
+	full    time.Time
+}
+
+type quotaDay struct {
+	key string
+	day time.Time
+}
+
+// localQuota counts a client's requests on a day. Pending is the part of Used not yet written to
+// the database.
+type localQuota struct {
+	used    int64
+	pending int64
+}
+
+func (l *localLimiter) Allow(ctx context.Context, key string, limit Limit) (Decision, error) {
+	now := l.now()
+	day := now.UTC().Truncate(24 * time.Hour)
+
+	var quota *localQuota
+	if limit.DailyQuota > 0 {
+		var err error
+		if quota, err = l.quota(ctx, key, day); err != nil {
+			return Decision{}, err
+		}
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	b, ok := l.buckets[key]
+	if !ok {
+		if len(l.buckets) >= l.maxKeys {
+			l.evictBucket(now)
+		}
+		b = &localBucket{tokens: float64(limit.Burst), updated: now}
+		l.buckets[key] = b
+	}
+	b.tokens = math.Min(float64(limit.Burst), b.tokens+now.Sub(b.updated).Seconds()*limit.Rate)
+	b.updated = now
+
+	bucket := Bucket{Tokens: b.tokens}
+	if b.tokens >= 1 && (quota == nil || quota.used < limit.DailyQuota) {
+		b.tokens--
+		bucket = Bucket{Tokens: b.tokens, Allowed: true}
+		if quota != nil {
+			quota.used++
+			quota.pending++
+		}
+	}
+	b.full = now.Add(time.Duration((float64(limit.Burst) - b.tokens) / limit.Rate * float64(time.Second)))
+
+	var used int64
+	if quota != nil {
+		used = quota.used
+	}
+	return decide(now, limit, bucket, used), nil
+}
+
+// quota returns the count for key on day, loading it from the database the first time key is
+// seen that day.
+func (l *localLimiter) quota(ctx context.Context, key string, day time.Time) (*localQuota, error) {
+	id := quotaDay{key: key, day: day}
+	l.mu.Lock()
+	quota, ok := l.quotas[id]
+	l.mu.Unlock()
+	if ok {
+		return quota, nil
+	}
+
+	used, err := l.repository.GetQuotaUsage(ctx, key, day)
+	if err != nil {
+		return nil, err
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if quota, ok := l.quotas[id]; ok {
+		return quota, nil
+	}
+	if len(l.quotas) >= l.maxKeys {
+		l.evictQuota()
+	}
+	quota = &localQuota{used: used}
+	l.quotas[id] = quota
+	return quota, nil
+}
+
+// evictBucket makes room for a new bucket, preferring one that has refilled, which can be
+// forgotten without changing anything.
+func (l *localLimiter) evictBucket(now time.Time) {
+	var victim string
+	scanned := 0
+	for key, b := range l.buckets {
+		victim = key
+		scanned++
+		if !now.Before(b.full) || scanned == evictionScan {
+			break
+		}
+	}
+	delete(l.buckets, victim)
+}
+
+// evictQuota makes room for a new quota by forgetting one with nothing left to write, which will
+// be read back from the database if its client returns. If none is found, the map grows until
+// the next flush.
+func (l *localLimiter) evictQuota() {
+	scanned := 0
+	for id, quota := range l.quotas {
+		if quota.pending == 0 {
+			delete(l.quotas, id)
+			return
+		}
+		if scanned++; scanned == evictionScan {
+			return
+		}
+	}
+}
+
+// Run writes quota use to the database every few seconds, and forgets buckets that have refilled
+// and quotas for past days. It flushes once more when ctx is cancelled.
+func (l *localLimiter) Run(ctx context.Context) {
+	ticker := time.NewTicker(5 * time.Second)
+	defer ticker.Stop()
+	lastExpired := time.Time{}
+	for {
+		select {
+		case <-ctx.Done():
+			l.flush(context.Background())
+			return
+		case <-ticker.C:
+			l.flush(ctx)
+			l.forget()
+			if now := l.now(); now.Sub(lastExpired) > time.Hour {
+				lastExpired = now
+				if err := l.repository.DeleteExpired(ctx, now.UTC().Truncate(24*time.Hour).AddDate(0, 0, -7)); err != nil {
+					level.Error(l.logger).Log("err", err)
+				}
+			}
+		}
+	}
+}
+
+func (l *localLimiter) flush(ctx context.Context) {
+	pending := map[quotaDay]int64{}
+	l.mu.Lock()
+	for id, quota := range l.quotas {
+		if quota.pending > 0 {
+			pending[id] = quota.pending
+			quota.pending = 0
+		}
+	}
+	l.mu.Unlock()
+
+	for id, n := range pending {
+		if err := l.repository.AddQuotaUsage(ctx, id.key, id.day, n); err != nil {
+			level.Error(l.logger).Log("err", err)
+			l.mu.Lock()
+			if quota, ok := l.quotas[id]; ok {
+				quota.pending += n
+			}
+			l.mu.Unlock()
+		}
+	}
+}
+
+func (l *localLimiter) forget() {
+	now := l.now()
+	today := now.UTC().Truncate(24 * time.Hour)
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for key, b := range l.buckets {
+		if !now.Before(b.full) {
+			delete(l.buckets, key)
+		}
+	}
+	for id, quota := range l.quotas {
+		if quota.pending == 0 && id.day.Before(today) {
+			delete(l.quotas, id)
+		}
+	}
+}
+
+// NewDistributedLimiter keeps buckets and quotas in the database, so every instance sharing it
+// limits clients together, at the cost of a round trip or two per request.
+func NewDistributedLimiter(repository Repository, logger log.Logger) Limiter {
+	return &distributedLimiter{repository: repository, logger: logger, now: time.Now}
+}
+
+type distributedLimiter struct {
+	repository Repository
+	logger     log.Logger
+	now        func() time.Time
+}
+
+func (l *distributedLimiter) Allow(ctx context.Context, key string, limit Limit) (Decision, error) {
+	now := l.now()
+	bucket, err := l.repository.TakeToken(ctx, key, limit)
+	if err != nil {
+		return Decision{}, err
+	}
+
+	var used int64
+	if limit.DailyQuota > 0 {
+		day := now.UTC().Truncate(24 * time.Hour)
+		if bucket.Allowed {
+			var counted bool
+			if used, counted, err = l.repository.UseQuota(ctx, key, day, limit.DailyQuota); err != nil {
+				return Decision{}, err
+			}
+			bucket.Allowed = counted
+		} else if used, err = l.repository.GetQuotaUsage(ctx, key, day); err != nil {
+			return Decision{}, err
+		}
+	}
+	return decide(now, limit, bucket, used), nil
+}
+
+func (l *distributedLimiter) Run(ctx context.Context) {
+	ticker := time.NewTicker(time.Hour)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := l.repository.DeleteExpired(ctx, l.now().UTC().Truncate(24*time.Hour).AddDate(0, 0, -7)); err != nil {
+				level.Error(l.logger).Log("err", err)
+			}
+		}
+	}
+}
+
+func decide(now time.Time, limit Limit, bucket Bucket, used int64) Decision {
+	decision := Decision{
+		Allowed:   bucket.Allowed,
+		Remaining: int(math.Max(0, math.Floor(bucket.Tokens))),
+		Reset:     seconds((float64(limit.Burst) - bucket.Tokens) / limit.Rate),
+	}
+	if limit.DailyQuota > 0 {
+		decision.QuotaRemaining = limit.DailyQuota - used
+		if decision.QuotaRemaining < 0 {
+			decision.QuotaRemaining = 0
+		}
+		decision.QuotaReset = now.UTC().Truncate(24*time.Hour).AddDate(0, 0, 1).Sub(now)
+	}
+	if !decision.Allowed {
+		if limit.DailyQuota > 0 && used >= limit.DailyQuota {
+			decision.RetryAfter = decision.QuotaReset
+		} else {
+			decision.RetryAfter = seconds((1 - bucket.Tokens) / limit.Rate)
+		}
+	}
+	return decision
+}
+
+func seconds(s float64) time.Duration {
+	if s <= 0 {
+		return 0
+	}
+	return time.Duration(s * float64(time.Second))
+}
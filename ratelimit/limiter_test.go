@@ -0,0 +1,180 @@
+package ratelimit
+
+import (
+	"context"
+	"github.com/go-kit/log"
+	"github.com/rchauhan9/sportech/auth"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+)
+
+type fakeRepository struct {
+	Repository
+	usage map[quotaDay]int64
+}
+
+func (f *fakeRepository) GetQuotaUsage(_ context.Context, key string, day time.Time) (int64, error) {
+	return f.usage[quotaDay{key: key, day: day}], nil
+}
+
+func (f *fakeRepository) AddQuotaUsage(_ context.Context, key string, day time.Time, n int64) error {
+	f.usage[quotaDay{key: key, day: day}] += n
+	return nil
+}
+
+// fakeAuthenticator accepts "valid-key" and "other-key" as the same client.
+type fakeAuthenticator struct{}
+
+func (fakeAuthenticator) Authenticate(_ context.Context, credential string) (auth.Principal, error) {
+	if credential != "valid-key" && credential != "other-key" {
+		return auth.Principal{}, auth.ErrUnauthenticated
+	}
+	return auth.Principal{Subject: "client"}, nil
+}
+
+type clock struct {
+	now time.Time
+}
+
+func (c *clock) Now() time.Time {
+	return c.now
+}
+
+func newTestLimiter(repository Repository) (*localLimiter, *clock) {
+	c := &clock{now: time.Date(2022, time.March, 1, 12, 0, 0, 0, time.UTC)}
+	l := NewLocalLimiter(repository, 100, log.NewNopLogger()).(*localLimiter)
+	l.now = c.Now
+	return l, c
+}
+
+func TestBucketRefills(t *testing.T) {
+	l, c := newTestLimiter(&fakeRepository{usage: map[quotaDay]int64{}})
+	limit := Limit{Rate: 2, Burst: 3}
+
+	for i := 2; i >= 0; i-- {
+		decision, err := l.Allow(context.Background(), "players:ip:1", limit)
+		require.NoError(t, err)
+		require.True(t, decision.Allowed)
+		require.Equal(t, i, decision.Remaining)
+	}
+
+	decision, err := l.Allow(context.Background(), "players:ip:1", limit)
+	require.NoError(t, err)
+	require.False(t, decision.Allowed)
+	require.Equal(t, 500*time.Millisecond, decision.RetryAfter)
+	require.Equal(t, 1500*time.Millisecond, decision.Reset)
+
+	decision, err = l.Allow(context.Background(), "players:ip:2", limit)
+	require.NoError(t, err)
+	require.True(t, decision.Allowed)
+
+	c.now = c.now.Add(500 * time.Millisecond)
+	decision, err = l.Allow(context.Background(), "players:ip:1", limit)
+	require.NoError(t, err)
+	require.True(t, decision.Allowed)
+}
+
+func TestQuotaSurvivesRestart(t *testing.T) {
+	repository := &fakeRepository{usage: map[quotaDay]int64{}}
+	limit := Limit{Rate: 100, Burst: 100, DailyQuota: 3}
+
+	l, _ := newTestLimiter(repository)
+	for i := 0; i < 2; i++ {
+		decision, err := l.Allow(context.Background(), "players:key:1", limit)
+		require.NoError(t, err)
+		require.True(t, decision.Allowed)
+	}
+	l.flush(context.Background())
+
+	restarted, c := newTestLimiter(repository)
+	decision, err := restarted.Allow(context.Background(), "players:key:1", limit)
+	require.NoError(t, err)
+	require.True(t, decision.Allowed)
+	require.Equal(t, int64(0), decision.QuotaRemaining)
+
+	decision, err = restarted.Allow(context.Background(), "players:key:1", limit)
+	require.NoError(t, err)
+	require.False(t, decision.Allowed)
+	require.Equal(t, 12*time.Hour, decision.RetryAfter)
+
+	c.now = c.now.Add(12 * time.Hour)
+	decision, err = restarted.Allow(context.Background(), "players:key:1", limit)
+	require.NoError(t, err)
+	require.True(t, decision.Allowed)
+	require.Equal(t, int64(2), decision.QuotaRemaining)
+}
+
+func TestHandler(t *testing.T) {
+	l, _ := newTestLimiter(&fakeRepository{usage: map[quotaDay]int64{}})
+	served := 0
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		served++
+	})
+	h := MakeHandler(next, l, "players", Limit{Rate: 1, Burst: 1, DailyQuota: 10}, fakeAuthenticator{}, true, log.NewNopLogger())
+
+	request := func(forwardedFor string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodGet, "/players/", nil)
+		r.Header.Set("X-Forwarded-For", forwardedFor)
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+		return w
+	}
+
+	w := request("10.0.0.1, 203.0.113.7")
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "1", w.Header().Get("X-RateLimit-Limit"))
+	require.Equal(t, "0", w.Header().Get("X-RateLimit-Remaining"))
+	require.Equal(t, "1", w.Header().Get("X-RateLimit-Reset"))
+	require.Equal(t, "9", w.Header().Get("X-RateLimit-Quota-Remaining"))
+
+	// Only the address the proxy appended counts, so changing the first does not help.
+	w = request("10.0.0.2, 203.0.113.7")
+	require.Equal(t, http.StatusTooManyRequests, w.Code)
+	require.Equal(t, "1", w.Header().Get("Retry-After"))
+	require.Equal(t, 1, served)
+
+	w = request("203.0.113.8")
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, 2, served)
+}
+
+func TestHandlerKeysOnVerifiedPrincipal(t *testing.T) {
+	l, _ := newTestLimiter(&fakeRepository{usage: map[quotaDay]int64{}})
+	h := MakeHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}), l, "players", Limit{Rate: 1, Burst: 1}, fakeAuthenticator{}, false, log.NewNopLogger())
+
+	request := func(remoteAddr string, credential string) int {
+		r := httptest.NewRequest(http.MethodGet, "/players/", nil)
+		r.RemoteAddr = remoteAddr
+		r = r.WithContext(auth.WithCredential(r.Context(), credential))
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+		return w.Code
+	}
+
+	// Made-up credentials fall back to the client's address, so they do not earn a fresh bucket.
+	require.Equal(t, http.StatusOK, request("203.0.113.7:1234", "made-up-1"))
+	require.Equal(t, http.StatusTooManyRequests, request("203.0.113.7:1234", "made-up-2"))
+
+	// A verified credential is limited by who it belongs to, wherever it is sent from.
+	require.Equal(t, http.StatusOK, request("203.0.113.7:1234", "valid-key"))
+	require.Equal(t, http.StatusTooManyRequests, request("198.51.100.1:1234", "other-key"))
+}
+
+func TestLocalLimiterBoundsKeys(t *testing.T) {
+	repository := &fakeRepository{usage: map[quotaDay]int64{}}
+	l, _ := newTestLimiter(repository)
+	l.maxKeys = 3
+	limit := Limit{Rate: 1, Burst: 1, DailyQuota: 10}
+
+	for i := 0; i < 10; i++ {
+		_, err := l.Allow(context.Background(), "players:ip:"+strconv.Itoa(i), limit)
+		require.NoError(t, err)
+		l.flush(context.Background())
+	}
+	require.Len(t, l.buckets, 3)
+	require.Len(t, l.quotas, 3)
+}
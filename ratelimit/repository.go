@@ -0,0 +1,108 @@
+package ratelimit
+
+import (
+	"context"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/pkg/errors"
+	"time"
+)
+
+type Repository interface {
+	TakeToken(ctx context.Context, key string, limit Limit) (Bucket, error)
+	GetQuotaUsage(ctx context.Context, key string, day time.Time) (int64, error)
+	AddQuotaUsage(ctx context.Context, key string, day time.Time, n int64) error
+	UseQuota(ctx context.Context, key string, day time.Time, quota int64) (int64, bool, error)
+	DeleteExpired(ctx context.Context, before time.Time) error
+}
+
+func NewRepository(dbPool *pgxpool.Pool) Repository {
+	return &repository{dbPool}
+}
+
+type repository struct {
+	pool *pgxpool.Pool
+}
+
+// TakeToken refills the bucket for the time since it was last used and takes a token from it if
+// one is left, in a single statement so that instances sharing the database share the bucket.
+// Time is measured by the database's clock.
+func (r *repository) TakeToken(ctx context.Context, key string, limit Limit) (Bucket, error) {
+	query := `
+	    INSERT INTO rate_limit_buckets AS b (bucket_key, tokens, allowed, updated_at)
+	    VALUES
+	    ($1, $2::DOUBLE PRECISION - 1, true, now())
+	    ON CONFLICT (bucket_key) DO UPDATE SET
+	        -- The refilled bucket is worked out three times over, as SET cannot refer to itself.
+	        tokens = LEAST($2, b.tokens + EXTRACT(EPOCH FROM now() - b.updated_at) * $3::DOUBLE PRECISION)
+	            - CASE WHEN LEAST($2, b.tokens + EXTRACT(EPOCH FROM now() - b.updated_at) * $3::DOUBLE PRECISION) >= 1 THEN 1 ELSE 0 END,
+	        allowed = LEAST($2, b.tokens + EXTRACT(EPOCH FROM now() - b.updated_at) * $3::DOUBLE PRECISION) >= 1,
+	        updated_at = now()
+	    RETURNING tokens, allowed
+	`
+	var bucket Bucket
+	if err := r.pool.QueryRow(ctx, query, key, float64(limit.Burst), limit.Rate).Scan(&bucket.Tokens, &bucket.Allowed); err != nil {
+		return Bucket{}, errors.Wrapf(err, "error taking token from bucket %s", key)
+	}
+	return bucket, nil
+}
+
+func (r *repository) GetQuotaUsage(ctx context.Context, key string, day time.Time) (int64, error) {
+	query := `
+	    SELECT used
+	    FROM rate_limit_quotas
+	    WHERE quota_key = $1 AND day = $2
+	`
+	var used int64
+	err := r.pool.QueryRow(ctx, query, key, day).Scan(&used)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return 0, errors.Wrapf(err, "error fetching quota usage for %s", key)
+	}
+	return used, nil
+}
+
+func (r *repository) AddQuotaUsage(ctx context.Context, key string, day time.Time, n int64) error {
+	query := `
+	    INSERT INTO rate_limit_quotas AS q (quota_key, day, used)
+	    VALUES
+	    ($1, $2, $3)
+	    ON CONFLICT (quota_key, day) DO UPDATE SET used = q.used + EXCLUDED.used
+	`
+	if _, err := r.pool.Exec(ctx, query, key, day, n); err != nil {
+		return errors.Wrapf(err, "error recording quota usage for %s", key)
+	}
+	return nil
+}
+
+// UseQuota counts a request against the day's quota unless it is used up, returning how much is
+// used and whether the request was counted.
+func (r *repository) UseQuota(ctx context.Context, key string, day time.Time, quota int64) (int64, bool, error) {
+	query := `
+	    INSERT INTO rate_limit_quotas AS q (quota_key, day, used)
+	    VALUES
+	    ($1, $2, 1)
+	    ON CONFLICT (quota_key, day) DO UPDATE SET used = q.used + 1
+	    WHERE q.used < $3
+	    RETURNING used
+	`
+	var used int64
+	err := r.pool.QueryRow(ctx, query, key, day, quota).Scan(&used)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return quota, false, nil
+	}
+	if err != nil {
+		return 0, false, errors.Wrapf(err, "error using quota for %s", key)
+	}
+	return used, true, nil
+}
+
+// DeleteExpired drops quotas for days before before, and buckets untouched since then.
+func (r *repository) DeleteExpired(ctx context.Context, before time.Time) error {
+	if _, err := r.pool.Exec(ctx, "DELETE FROM rate_limit_quotas WHERE day < $1", before); err != nil {
+		return errors.Wrap(err, "error deleting expired quotas")
+	}
+	if _, err := r.pool.Exec(ctx, "DELETE FROM rate_limit_buckets WHERE updated_at < $1", before); err != nil {
+		return errors.Wrap(err, "error deleting expired buckets")
+	}
+	return nil
+}
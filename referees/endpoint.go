@@ -0,0 +1,73 @@
+package referees
+
+import (
+	"context"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/rchauhan9/sportech/stream"
+)
+
+type listRefereesRequest struct {
+	Format string
+}
+
+type listRefereesResponse struct {
+	Referees stream.Stream[Referee] `json:"referees"`
+	format   string
+}
+
+type getRefereeRequest struct {
+	ID string
+}
+
+type getRefereeResponse struct {
+	Referee Referee `json:"referee"`
+}
+
+type listRefereeMatchesRequest struct {
+	ID     string
+	Format string
+}
+
+type listRefereeMatchesResponse struct {
+	Matches stream.Stream[MatchAssignment] `json:"matches"`
+	format  string
+}
+
+type getRefereeStatsRequest struct {
+	ID string
+}
+
+type getRefereeStatsResponse struct {
+	Stats DisciplineStats `json:"stats"`
+}
+
+func MakeListRefereesEndpoint(svc Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(listRefereesRequest)
+		return listRefereesResponse{Referees: svc.StreamReferees, format: req.Format}, nil
+	}
+}
+
+func MakeGetRefereeEndpoint(svc Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(getRefereeRequest)
+		referee, err := svc.GetReferee(ctx, req.ID)
+		return getRefereeResponse{Referee: referee}, err
+	}
+}
+
+func MakeListRefereeMatchesEndpoint(svc Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(listRefereeMatchesRequest)
+		matches, err := svc.ListRefereeMatches(ctx, req.ID)
+		return listRefereeMatchesResponse{Matches: stream.Slice(matches), format: req.Format}, err
+	}
+}
+
+func MakeGetRefereeStatsEndpoint(svc Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(getRefereeStatsRequest)
+		stats, err := svc.GetRefereeDisciplineStats(ctx, req.ID)
+		return getRefereeStatsResponse{Stats: stats}, err
+	}
+}
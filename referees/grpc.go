@@ -0,0 +1,183 @@
+package referees
+
+import (
+	"context"
+	"github.com/go-kit/kit/endpoint"
+	grpctransport "github.com/go-kit/kit/transport/grpc"
+	"github.com/pkg/errors"
+	"github.com/rchauhan9/sportech/auth"
+	"github.com/rchauhan9/sportech/commons/go/grpcutil"
+	pb "github.com/rchauhan9/sportech/pb/sportech/v1"
+	"github.com/rchauhan9/sportech/persons"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type grpcServer struct {
+	pb.UnimplementedRefereeServiceServer
+	listReferees       grpctransport.Handler
+	getReferee         grpctransport.Handler
+	listRefereeMatches grpctransport.Handler
+	getRefereeStats    grpctransport.Handler
+}
+
+func MakeGRPCServer(listRefereesEndpoint endpoint.Endpoint, getRefereeEndpoint endpoint.Endpoint, listRefereeMatchesEndpoint endpoint.Endpoint, getRefereeStatsEndpoint endpoint.Endpoint) pb.RefereeServiceServer {
+	return &grpcServer{
+		listReferees: grpctransport.NewServer(
+			listRefereesEndpoint,
+			decodeGRPCListRefereesRequest,
+			encodeGRPCListRefereesResponse,
+		),
+		getReferee: grpctransport.NewServer(
+			getRefereeEndpoint,
+			decodeGRPCGetRefereeRequest,
+			encodeGRPCGetRefereeResponse,
+		),
+		listRefereeMatches: grpctransport.NewServer(
+			listRefereeMatchesEndpoint,
+			decodeGRPCListRefereeMatchesRequest,
+			encodeGRPCListRefereeMatchesResponse,
+		),
+		getRefereeStats: grpctransport.NewServer(
+			getRefereeStatsEndpoint,
+			decodeGRPCGetRefereeStatsRequest,
+			encodeGRPCGetRefereeStatsResponse,
+		),
+	}
+}
+
+func (s *grpcServer) ListReferees(ctx context.Context, req *pb.ListRefereesRequest) (*pb.ListRefereesResponse, error) {
+	_, rep, err := s.listReferees.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeGRPCError(err)
+	}
+	return rep.(*pb.ListRefereesResponse), nil
+}
+
+func (s *grpcServer) GetReferee(ctx context.Context, req *pb.GetRefereeRequest) (*pb.GetRefereeResponse, error) {
+	_, rep, err := s.getReferee.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeGRPCError(err)
+	}
+	return rep.(*pb.GetRefereeResponse), nil
+}
+
+func (s *grpcServer) ListRefereeMatches(ctx context.Context, req *pb.ListRefereeMatchesRequest) (*pb.ListRefereeMatchesResponse, error) {
+	_, rep, err := s.listRefereeMatches.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeGRPCError(err)
+	}
+	return rep.(*pb.ListRefereeMatchesResponse), nil
+}
+
+func (s *grpcServer) GetRefereeStats(ctx context.Context, req *pb.GetRefereeStatsRequest) (*pb.GetRefereeStatsResponse, error) {
+	_, rep, err := s.getRefereeStats.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeGRPCError(err)
+	}
+	return rep.(*pb.GetRefereeStatsResponse), nil
+}
+
+func decodeGRPCListRefereesRequest(_ context.Context, _ interface{}) (interface{}, error) {
+	return listRefereesRequest{}, nil
+}
+
+func encodeGRPCListRefereesResponse(ctx context.Context, response interface{}) (interface{}, error) {
+	resp := response.(listRefereesResponse)
+	var referees []*pb.Referee
+	if err := resp.Referees(ctx, func(referee Referee) error {
+		referees = append(referees, encodeGRPCReferee(referee))
+		return nil
+	}); err != nil {
+		return nil, encodeGRPCError(err)
+	}
+	return &pb.ListRefereesResponse{Referees: referees}, nil
+}
+
+func decodeGRPCGetRefereeRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.GetRefereeRequest)
+	return getRefereeRequest{ID: req.Id}, nil
+}
+
+func encodeGRPCGetRefereeResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(getRefereeResponse)
+	return &pb.GetRefereeResponse{Referee: encodeGRPCReferee(resp.Referee)}, nil
+}
+
+func decodeGRPCListRefereeMatchesRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.ListRefereeMatchesRequest)
+	return listRefereeMatchesRequest{ID: req.Id}, nil
+}
+
+func encodeGRPCListRefereeMatchesResponse(ctx context.Context, response interface{}) (interface{}, error) {
+	resp := response.(listRefereeMatchesResponse)
+	assignments, err := resp.Matches.Collect(ctx)
+	if err != nil {
+		return nil, encodeGRPCError(err)
+	}
+	matches := make([]*pb.MatchAssignment, len(assignments))
+	for i, match := range assignments {
+		matches[i] = &pb.MatchAssignment{
+			MatchId:  match.MatchID,
+			Role:     match.Role,
+			HomeTeam: match.HomeTeam,
+			AwayTeam: match.AwayTeam,
+			League:   match.League,
+			Stadium:  match.Stadium,
+			Kickoff:  timestamppb.New(match.Kickoff),
+		}
+	}
+	return &pb.ListRefereeMatchesResponse{Matches: matches}, nil
+}
+
+func decodeGRPCGetRefereeStatsRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.GetRefereeStatsRequest)
+	return getRefereeStatsRequest{ID: req.Id}, nil
+}
+
+func encodeGRPCGetRefereeStatsResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(getRefereeStatsResponse)
+	return &pb.GetRefereeStatsResponse{
+		Stats: &pb.DisciplineStats{
+			Referee:                 resp.Stats.Referee,
+			Matches:                 resp.Stats.Matches,
+			YellowCards:             resp.Stats.YellowCards,
+			RedCards:                resp.Stats.RedCards,
+			PenaltiesAwarded:        resp.Stats.PenaltiesAwarded,
+			YellowCardsPerGame:      resp.Stats.YellowCardsPerGame,
+			RedCardsPerGame:         resp.Stats.RedCardsPerGame,
+			CardsPerGame:            resp.Stats.CardsPerGame,
+			PenaltiesAwardedPerGame: resp.Stats.PenaltiesAwardedPerGame,
+		},
+	}, nil
+}
+
+func encodeGRPCReferee(referee Referee) *pb.Referee {
+	return &pb.Referee{
+		Id:          referee.ID,
+		FirstName:   referee.FirstName,
+		MiddleNames: referee.MiddleNames,
+		LastName:    referee.LastName,
+		KnownAs:     referee.KnownAs,
+		DateOfBirth: timestamppb.New(referee.DateOfBirth),
+		Nationality: referee.Nationality,
+		Aliases:     persons.EncodeGRPCAliases(referee.Aliases),
+		Started:     timestamppb.New(referee.Started),
+		Ended:       grpcutil.OptionalTimestamp(referee.Ended),
+	}
+}
+
+// encode errors from business-logic as gRPC statuses
+func encodeGRPCError(err error) error {
+	switch errors.Cause(err) {
+	case ErrInvalidArgument:
+		return status.Error(codes.InvalidArgument, err.Error())
+	case auth.ErrUnauthenticated:
+		return status.Error(codes.Unauthenticated, err.Error())
+	case auth.ErrForbidden:
+		return status.Error(codes.PermissionDenied, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
@@ -0,0 +1,155 @@
+package referees
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/go-kit/kit/endpoint"
+	kithttp "github.com/go-kit/kit/transport/http"
+	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+	"github.com/rchauhan9/sportech/auth"
+	"github.com/rchauhan9/sportech/commons/go/logging"
+	"github.com/rchauhan9/sportech/commons/go/queryutil"
+	"github.com/rchauhan9/sportech/stream"
+	"net/http"
+)
+
+func MakeHandler(
+	listRefereesEndpoint endpoint.Endpoint,
+	getRefereeEndpoint endpoint.Endpoint,
+	listRefereeMatchesEndpoint endpoint.Endpoint,
+	getRefereeStatsEndpoint endpoint.Endpoint,
+) http.Handler {
+	r := mux.NewRouter()
+
+	listRefereesHandler := kithttp.NewServer(
+		listRefereesEndpoint,
+		decodeListRefereesRequest,
+		encodeListRefereesResponse,
+		kithttp.ServerErrorEncoder(encodeError),
+	)
+
+	getRefereeHandler := kithttp.NewServer(
+		getRefereeEndpoint,
+		decodeGetRefereeRequest,
+		encodeGetRefereeResponse,
+		kithttp.ServerErrorEncoder(encodeError),
+	)
+
+	listRefereeMatchesHandler := kithttp.NewServer(
+		listRefereeMatchesEndpoint,
+		decodeListRefereeMatchesRequest,
+		encodeListRefereeMatchesResponse,
+		kithttp.ServerErrorEncoder(encodeError),
+	)
+
+	getRefereeStatsHandler := kithttp.NewServer(
+		getRefereeStatsEndpoint,
+		decodeGetRefereeStatsRequest,
+		encodeGetRefereeStatsResponse,
+		kithttp.ServerErrorEncoder(encodeError),
+	)
+
+	r.Handle("/referees/{id}/matches", listRefereeMatchesHandler).Methods("GET")
+	r.Handle("/referees/{id}/stats", getRefereeStatsHandler).Methods("GET")
+	r.Handle("/referees/{id}", getRefereeHandler).Methods("GET")
+	r.Handle("/referees/", listRefereesHandler).Methods("GET")
+
+	return r
+}
+
+func decodeListRefereesRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	format, err := queryutil.Format(r, ErrInvalidArgument)
+	if err != nil {
+		return nil, err
+	}
+	return listRefereesRequest{Format: format}, nil
+}
+
+func encodeListRefereesResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	if e, ok := response.(errorer); ok && e.error() != nil {
+		encodeError(ctx, e.error(), w)
+		return nil
+	}
+	resp := response.(listRefereesResponse)
+	return stream.Encode(ctx, w, resp.format, "referees", resp.Referees)
+}
+
+func decodeGetRefereeRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	vars := mux.Vars(r)
+	id, ok := vars["id"]
+	if !ok {
+		return nil, errors.New("bad route")
+	}
+	return getRefereeRequest{ID: id}, nil
+}
+
+func encodeGetRefereeResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	if e, ok := response.(errorer); ok && e.error() != nil {
+		encodeError(ctx, e.error(), w)
+		return nil
+	}
+	return json.NewEncoder(w).Encode(response)
+}
+
+func decodeListRefereeMatchesRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	vars := mux.Vars(r)
+	id, ok := vars["id"]
+	if !ok {
+		return nil, errors.New("bad route")
+	}
+	format, err := queryutil.Format(r, ErrInvalidArgument)
+	if err != nil {
+		return nil, err
+	}
+	return listRefereeMatchesRequest{ID: id, Format: format}, nil
+}
+
+func encodeListRefereeMatchesResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	if e, ok := response.(errorer); ok && e.error() != nil {
+		encodeError(ctx, e.error(), w)
+		return nil
+	}
+	resp := response.(listRefereeMatchesResponse)
+	return stream.Encode(ctx, w, resp.format, "matches", resp.Matches)
+}
+
+func decodeGetRefereeStatsRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	vars := mux.Vars(r)
+	id, ok := vars["id"]
+	if !ok {
+		return nil, errors.New("bad route")
+	}
+	return getRefereeStatsRequest{ID: id}, nil
+}
+
+func encodeGetRefereeStatsResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	if e, ok := response.(errorer); ok && e.error() != nil {
+		encodeError(ctx, e.error(), w)
+		return nil
+	}
+	return json.NewEncoder(w).Encode(response)
+}
+
+type errorer interface {
+	error() error
+}
+
+// encode errors from business-logic
+func encodeError(ctx context.Context, err error, w http.ResponseWriter) {
+	logging.RecordError(ctx, err)
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	switch errors.Cause(err) {
+	case ErrInvalidArgument:
+		w.WriteHeader(http.StatusBadRequest)
+	case auth.ErrUnauthenticated:
+		w.WriteHeader(http.StatusUnauthorized)
+	case auth.ErrForbidden:
+		w.WriteHeader(http.StatusForbidden)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"error": err.Error(),
+	})
+}
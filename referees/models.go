@@ -0,0 +1,60 @@
+package referees
+
+import (
+	"github.com/rchauhan9/sportech/persons"
+	"time"
+)
+
+// Roles a referee can be assigned to in a match.
+const (
+	RoleReferee               = "referee"
+	RoleAssistantReferee      = "assistant_referee"
+	RoleFourthOfficial        = "fourth_official"
+	RoleVideoAssistantReferee = "video_assistant_referee"
+)
+
+type Referee struct {
+	ID          string          `json:"id"`
+	FirstName   string          `json:"firstName"`
+	MiddleNames *string         `json:"middleNames"`
+	LastName    string          `json:"lastName"`
+	KnownAs     *string         `json:"knownAs"`
+	DateOfBirth time.Time       `json:"dateOfBirth"`
+	Nationality string          `json:"nationality"`
+	Aliases     []persons.Alias `json:"aliases"`
+	Started     time.Time       `json:"started"`
+	Ended       *time.Time      `json:"ended"`
+}
+
+type RefereeDB struct {
+	ID       string
+	PersonID string
+	Started  time.Time
+	Ended    *time.Time
+	// Person is only filled in by StreamReferees and ListReferees.
+	Person persons.Person
+}
+
+type MatchAssignment struct {
+	MatchID  string    `json:"matchId"`
+	Role     string    `json:"role"`
+	HomeTeam string    `json:"homeTeam"`
+	AwayTeam string    `json:"awayTeam"`
+	League   string    `json:"league"`
+	Stadium  string    `json:"stadium"`
+	Kickoff  time.Time `json:"kickoff"`
+}
+
+// DisciplineStats only counts matches where the official was the referee, as the
+// other roles don't hand out cards or award penalties.
+type DisciplineStats struct {
+	Referee                 string  `json:"referee"`
+	Matches                 int32   `json:"matches"`
+	YellowCards             int32   `json:"yellowCards"`
+	RedCards                int32   `json:"redCards"`
+	PenaltiesAwarded        int32   `json:"penaltiesAwarded"`
+	YellowCardsPerGame      float64 `json:"yellowCardsPerGame"`
+	RedCardsPerGame         float64 `json:"redCardsPerGame"`
+	CardsPerGame            float64 `json:"cardsPerGame"`
+	PenaltiesAwardedPerGame float64 `json:"penaltiesAwardedPerGame"`
+}
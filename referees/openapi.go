@@ -0,0 +1,41 @@
+package referees
+
+import (
+	"github.com/rchauhan9/sportech/openapi"
+	"net/http"
+)
+
+var Routes = []openapi.Route{
+	{
+		Method:   http.MethodGet,
+		Path:     "/referees/",
+		Tag:      "referees",
+		Summary:  "List referees",
+		Query:    []openapi.Parameter{openapi.FormatParam},
+		Response: listRefereesResponse{},
+		Formats:  true,
+	},
+	{
+		Method:   http.MethodGet,
+		Path:     "/referees/{id}",
+		Tag:      "referees",
+		Summary:  "Get a referee",
+		Response: getRefereeResponse{},
+	},
+	{
+		Method:   http.MethodGet,
+		Path:     "/referees/{id}/matches",
+		Tag:      "referees",
+		Summary:  "List the matches a referee has officiated",
+		Query:    []openapi.Parameter{openapi.FormatParam},
+		Response: listRefereeMatchesResponse{},
+		Formats:  true,
+	},
+	{
+		Method:   http.MethodGet,
+		Path:     "/referees/{id}/stats",
+		Tag:      "referees",
+		Summary:  "Get a referee's discipline stats",
+		Response: getRefereeStatsResponse{},
+	},
+}
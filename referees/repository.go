@@ -0,0 +1,160 @@
+package referees
+
+import (
+	"context"
+	"fmt"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/pkg/errors"
+	"github.com/rchauhan9/sportech/persons"
+)
+
+// Tables lists the tables referees and their matches are read from, the persons tables included.
+var Tables = append([]string{"referees", "match_officials", "matches", "match_events"}, persons.Tables...)
+
+type Repository interface {
+	ListReferees(ctx context.Context) ([]RefereeDB, error)
+	StreamReferees(ctx context.Context, yield func(RefereeDB) error) error
+	GetReferee(ctx context.Context, id string) (RefereeDB, error)
+	ListRefereeMatches(ctx context.Context, id string) ([]MatchAssignment, error)
+	GetRefereeDisciplineStats(ctx context.Context, id string) (DisciplineStats, error)
+}
+
+func NewRepository(dbPool *pgxpool.Pool) Repository {
+	return &repository{dbPool}
+}
+
+type repository struct {
+	pool *pgxpool.Pool
+}
+
+// StreamReferees reads each referee along with the person behind them, in one query.
+func (r *repository) StreamReferees(ctx context.Context, yield func(RefereeDB) error) error {
+	query := fmt.Sprintf(`
+		SELECT
+		    f.id,
+		    f.person_id,
+		    f.started,
+		    f.ended,%s
+	    FROM referees f%s
+	    ORDER BY f.started ASC
+	`, persons.Columns, persons.Join("f.person_id"))
+	rows, err := r.pool.Query(ctx, query)
+	if err != nil {
+		return errors.Wrap(err, "error fetching referees from database")
+	}
+
+	defer rows.Close()
+	for rows.Next() {
+		referee := RefereeDB{}
+		person := persons.Scanner{}
+		if err := rows.Scan(append([]interface{}{
+			&referee.ID,
+			&referee.PersonID,
+			&referee.Started,
+			&referee.Ended,
+		}, person.Targets()...)...); err != nil {
+			return errors.Wrap(err, "error scanning row from database")
+		}
+		referee.Person = person.Person()
+		if err := yield(referee); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+func (r *repository) ListReferees(ctx context.Context) ([]RefereeDB, error) {
+	var referees []RefereeDB
+	err := r.StreamReferees(ctx, func(referee RefereeDB) error {
+		referees = append(referees, referee)
+		return nil
+	})
+	return referees, err
+}
+
+func (r *repository) GetReferee(ctx context.Context, id string) (RefereeDB, error) {
+	query := `
+	    SELECT
+		    id,
+		    person_id,
+		    started,
+		    ended
+	    FROM referees
+	    WHERE id = $1
+	`
+	row := r.pool.QueryRow(ctx, query, id)
+	var referee RefereeDB
+	if err := row.Scan(
+		&referee.ID,
+		&referee.PersonID,
+		&referee.Started,
+		&referee.Ended,
+	); err != nil {
+		return referee, errors.Wrapf(err, "error getting referee with id %s", id)
+	}
+	return referee, nil
+}
+
+func (r *repository) ListRefereeMatches(ctx context.Context, id string) ([]MatchAssignment, error) {
+	query := `
+	    SELECT
+		    m.id,
+		    o.role,
+		    m.home_team_id,
+		    m.away_team_id,
+		    m.league_id,
+		    m.stadium_id,
+		    m.kickoff
+	    FROM match_officials o
+	    JOIN matches m ON m.id = o.match_id
+	    WHERE o.referee_id = $1
+	    ORDER BY m.kickoff DESC
+	`
+	rows, err := r.pool.Query(ctx, query, id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error fetching matches for referee with id %s", id)
+	}
+	defer rows.Close()
+
+	var assignments []MatchAssignment
+	for rows.Next() {
+		assignment := MatchAssignment{}
+		if err := rows.Scan(
+			&assignment.MatchID,
+			&assignment.Role,
+			&assignment.HomeTeam,
+			&assignment.AwayTeam,
+			&assignment.League,
+			&assignment.Stadium,
+			&assignment.Kickoff,
+		); err != nil {
+			return nil, errors.Wrap(err, "error scanning row from database")
+		}
+		assignments = append(assignments, assignment)
+	}
+	return assignments, rows.Err()
+}
+
+func (r *repository) GetRefereeDisciplineStats(ctx context.Context, id string) (DisciplineStats, error) {
+	query := `
+	    SELECT
+		    COUNT(DISTINCT o.match_id),
+		    COUNT(e.id) FILTER (WHERE e.event_type = 'yellow_card'),
+		    COUNT(e.id) FILTER (WHERE e.event_type = 'red_card'),
+		    COUNT(e.id) FILTER (WHERE e.event_type = 'penalty_awarded')
+	    FROM match_officials o
+	    LEFT JOIN match_events e ON e.match_id = o.match_id
+	    WHERE o.referee_id = $1 AND o.role = 'referee'
+	`
+	row := r.pool.QueryRow(ctx, query, id)
+	stats := DisciplineStats{Referee: id}
+	if err := row.Scan(
+		&stats.Matches,
+		&stats.YellowCards,
+		&stats.RedCards,
+		&stats.PenaltiesAwarded,
+	); err != nil {
+		return stats, errors.Wrapf(err, "error getting discipline stats for referee with id %s", id)
+	}
+	return stats, nil
+}
@@ -0,0 +1,89 @@
+package referees
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/rchauhan9/sportech/persons"
+	"github.com/rchauhan9/sportech/stream"
+)
+
+var ErrInvalidArgument = errors.New("invalid argument")
+
+type Service interface {
+	ListReferees(ctx context.Context) ([]Referee, error)
+	StreamReferees(ctx context.Context, yield func(Referee) error) error
+	GetReferee(ctx context.Context, id string) (Referee, error)
+	ListRefereeMatches(ctx context.Context, id string) ([]MatchAssignment, error)
+	GetRefereeDisciplineStats(ctx context.Context, id string) (DisciplineStats, error)
+}
+
+func NewService(repository Repository, personsService persons.Service) Service {
+	return &service{repository: repository, personsService: personsService}
+}
+
+type service struct {
+	repository     Repository
+	personsService persons.Service
+}
+
+func (s *service) ListReferees(ctx context.Context) ([]Referee, error) {
+	return stream.Stream[Referee](s.StreamReferees).Collect(ctx)
+}
+
+// StreamReferees relies on the repository joining in each referee's person, so that a list holds
+// a single connection for as long as it streams.
+func (s *service) StreamReferees(ctx context.Context, yield func(Referee) error) error {
+	return s.repository.StreamReferees(ctx, func(referee RefereeDB) error {
+		return yield(newReferee(referee, referee.Person))
+	})
+}
+
+func (s *service) GetReferee(ctx context.Context, id string) (Referee, error) {
+	referee, err := s.repository.GetReferee(ctx, id)
+	if err != nil {
+		return Referee{}, err
+	}
+
+	person, err := s.personsService.GetPerson(ctx, referee.PersonID)
+	if err != nil {
+		return Referee{}, errors.Wrapf(err, "error getting referee with id %s", id)
+	}
+
+	return newReferee(referee, person), nil
+}
+
+func (s *service) ListRefereeMatches(ctx context.Context, id string) ([]MatchAssignment, error) {
+	return s.repository.ListRefereeMatches(ctx, id)
+}
+
+func (s *service) GetRefereeDisciplineStats(ctx context.Context, id string) (DisciplineStats, error) {
+	stats, err := s.repository.GetRefereeDisciplineStats(ctx, id)
+	if err != nil {
+		return DisciplineStats{}, err
+	}
+
+	if stats.Matches > 0 {
+		matches := float64(stats.Matches)
+		stats.YellowCardsPerGame = float64(stats.YellowCards) / matches
+		stats.RedCardsPerGame = float64(stats.RedCards) / matches
+		stats.CardsPerGame = float64(stats.YellowCards+stats.RedCards) / matches
+		stats.PenaltiesAwardedPerGame = float64(stats.PenaltiesAwarded) / matches
+	}
+
+	return stats, nil
+}
+
+func newReferee(db RefereeDB, person persons.Person) Referee {
+	return Referee{
+		ID:          db.ID,
+		FirstName:   person.FirstName,
+		MiddleNames: person.MiddleNames,
+		LastName:    person.LastName,
+		KnownAs:     person.KnownAs,
+		DateOfBirth: person.DateOfBirth,
+		Nationality: person.Nationality,
+		Aliases:     person.Aliases,
+		Started:     db.Started,
+		Ended:       db.Ended,
+	}
+}
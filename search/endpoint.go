@@ -0,0 +1,27 @@
+package search
+
+import (
+	"context"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/rchauhan9/sportech/stream"
+)
+
+type searchRequest struct {
+	Q      string
+	Types  []string
+	Limit  int
+	Format string
+}
+
+type searchResponse struct {
+	Results stream.Stream[Result] `json:"results"`
+	format  string
+}
+
+func MakeSearchEndpoint(svc Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(searchRequest)
+		results, err := svc.Search(ctx, req.Q, req.Types, req.Limit)
+		return searchResponse{Results: stream.Slice(results), format: req.Format}, err
+	}
+}
@@ -0,0 +1,73 @@
+package search
+
+import (
+	"context"
+	"github.com/go-kit/kit/endpoint"
+	grpctransport "github.com/go-kit/kit/transport/grpc"
+	"github.com/pkg/errors"
+	"github.com/rchauhan9/sportech/auth"
+	pb "github.com/rchauhan9/sportech/pb/sportech/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type grpcServer struct {
+	pb.UnimplementedSearchServiceServer
+	search grpctransport.Handler
+}
+
+func MakeGRPCServer(searchEndpoint endpoint.Endpoint) pb.SearchServiceServer {
+	return &grpcServer{
+		search: grpctransport.NewServer(
+			searchEndpoint,
+			decodeGRPCSearchRequest,
+			encodeGRPCSearchResponse,
+		),
+	}
+}
+
+func (s *grpcServer) Search(ctx context.Context, req *pb.SearchRequest) (*pb.SearchResponse, error) {
+	_, rep, err := s.search.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeGRPCError(err)
+	}
+	return rep.(*pb.SearchResponse), nil
+}
+
+func decodeGRPCSearchRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.SearchRequest)
+	return searchRequest{Q: req.Q, Types: req.Types, Limit: int(req.Limit)}, nil
+}
+
+func encodeGRPCSearchResponse(ctx context.Context, response interface{}) (interface{}, error) {
+	resp := response.(searchResponse)
+	collected, err := resp.Results.Collect(ctx)
+	if err != nil {
+		return nil, encodeGRPCError(err)
+	}
+	results := make([]*pb.SearchResult, len(collected))
+	for i, result := range collected {
+		results[i] = &pb.SearchResult{
+			Type:      result.Type,
+			Id:        result.ID,
+			Name:      result.Name,
+			Score:     result.Score,
+			Highlight: result.Highlight,
+		}
+	}
+	return &pb.SearchResponse{Results: results}, nil
+}
+
+// encode errors from business-logic as gRPC statuses
+func encodeGRPCError(err error) error {
+	switch errors.Cause(err) {
+	case ErrInvalidArgument:
+		return status.Error(codes.InvalidArgument, err.Error())
+	case auth.ErrUnauthenticated:
+		return status.Error(codes.Unauthenticated, err.Error())
+	case auth.ErrForbidden:
+		return status.Error(codes.PermissionDenied, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
@@ -0,0 +1,62 @@
+package search
+
+import (
+	"context"
+	pb "github.com/rchauhan9/sportech/pb/sportech/v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"net"
+	"testing"
+)
+
+type fakeRepository struct {
+	results []Result
+}
+
+func (f *fakeRepository) Search(_ context.Context, _ string, _ string, _ []string, _ int) ([]Result, error) {
+	return f.results, nil
+}
+
+func newGRPCClient(t *testing.T, svc Service) pb.SearchServiceClient {
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	pb.RegisterSearchServiceServer(server, MakeGRPCServer(MakeSearchEndpoint(svc)))
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.Dial(
+		"bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	return pb.NewSearchServiceClient(conn)
+}
+
+func TestGRPCSearch(t *testing.T) {
+	repository := &fakeRepository{results: []Result{
+		{Type: TypeTeam, ID: "liverpool", Name: "Liverpool", Score: 0.9, Highlight: "<mark>Liverpool</mark>"},
+	}}
+	client := newGRPCClient(t, NewService(repository))
+
+	resp, err := client.Search(context.Background(), &pb.SearchRequest{Q: "liver"})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(resp.Results))
+	require.Equal(t, "liverpool", resp.Results[0].Id)
+	require.Equal(t, "<mark>Liverpool</mark>", resp.Results[0].Highlight)
+}
+
+func TestGRPCSearchInvalidArgument(t *testing.T) {
+	client := newGRPCClient(t, NewService(&fakeRepository{}))
+
+	_, err := client.Search(context.Background(), &pb.SearchRequest{Q: "liver", Types: []string{"referee"}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,21 @@
+package search
+
+// Types of entity that can be searched.
+const (
+	TypeTeam    = "team"
+	TypePerson  = "person"
+	TypeStadium = "stadium"
+	TypeLeague  = "league"
+)
+
+var AllTypes = []string{TypeTeam, TypePerson, TypeStadium, TypeLeague}
+
+// Result is a single ranked search hit. Highlight is the matched text, HTML-escaped, with matching
+// words wrapped in <mark></mark>; it may come from a nickname or former name rather than Name.
+type Result struct {
+	Type      string  `json:"type"`
+	ID        string  `json:"id"`
+	Name      string  `json:"name"`
+	Score     float64 `json:"score"`
+	Highlight string  `json:"highlight"`
+}
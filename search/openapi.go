@@ -0,0 +1,23 @@
+package search
+
+import (
+	"github.com/rchauhan9/sportech/openapi"
+	"net/http"
+)
+
+var Routes = []openapi.Route{
+	{
+		Method:  http.MethodGet,
+		Path:    "/search",
+		Tag:     "search",
+		Summary: "Search teams, people, stadiums and leagues",
+		Query: []openapi.Parameter{
+			{Name: "q", Type: "string", Description: "The text to search for.", Required: true},
+			openapi.QueryParam("types", "string", "A comma separated subset of team, person, stadium and league."),
+			openapi.QueryParam("limit", "integer", "The most results to return. Defaults to 20."),
+			openapi.FormatParam,
+		},
+		Response: searchResponse{},
+		Formats:  true,
+	},
+}
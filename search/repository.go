@@ -0,0 +1,210 @@
+package search
+
+import (
+	"context"
+	"fmt"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/pkg/errors"
+	"html"
+	"strings"
+)
+
+// startSel and stopSel mark matches in ts_headline output. They are control characters rather than
+// <mark></mark> so that the name can be escaped before the tags are put in.
+const (
+	startSel = "\x02"
+	stopSel  = "\x03"
+)
+
+var highlighter = strings.NewReplacer(startSel, "<mark>", stopSel, "</mark>")
+
+// highlight escapes a ts_headline result for HTML and wraps its matches in <mark></mark>.
+func highlight(headline string) string {
+	return highlighter.Replace(html.EscapeString(headline))
+}
+
+// Tables lists the tables every kind of search result is read from.
+var Tables = []string{"teams", "team_names", "persons", "person_aliases", "stadiums", "stadium_names", "leagues"}
+
+type Repository interface {
+	Search(ctx context.Context, text string, prefixQuery string, types []string, limit int) ([]Result, error)
+}
+
+func NewRepository(dbPool *pgxpool.Pool) Repository {
+	return &repository{dbPool}
+}
+
+type repository struct {
+	pool *pgxpool.Pool
+}
+
+// Each branch selects (type, id, name, score, highlight) for one kind of entity. Candidates are
+// found with index-backed operators: trigram word similarity (<%) for typos and partial words,
+// and a prefix tsquery (@@) so "Mo Salah" finds "Mohamed Salah". Prefix matches get a flat boost
+// on top of the trigram score so they rank above near-misses.
+var branches = map[string][]string{
+	TypeTeam: {`
+	    SELECT
+		    'team',
+		    t.id::TEXT,
+		    t.full_name,
+		    GREATEST(
+		        word_similarity(q.text, f_unaccent(lower(t.full_name))),
+		        word_similarity(q.text, f_unaccent(lower(t.medium_name))),
+		        COALESCE(word_similarity(q.text, f_unaccent(lower(t.nickname))), 0),
+		        similarity(q.text, lower(t.acronym))
+		    ) + CASE WHEN v.doc @@ q.query THEN 0.5 ELSE 0 END,
+		    ts_headline('sportech_search', t.full_name || COALESCE(' (' || t.nickname || ')', ''), q.query, q.options)
+	    FROM teams t
+	    CROSS JOIN q
+	    CROSS JOIN LATERAL (
+	        SELECT to_tsvector('sportech_search', t.full_name || ' ' || t.medium_name || ' ' || coalesce(t.nickname, '') || ' ' || t.acronym) AS doc
+	    ) v
+	    WHERE q.text <% f_unaccent(lower(t.full_name))
+	       OR q.text <% f_unaccent(lower(t.medium_name))
+	       OR q.text <% f_unaccent(lower(t.nickname))
+	       OR lower(t.acronym) = q.text
+	       OR v.doc @@ q.query
+	`, `
+	    SELECT
+		    'team',
+		    t.id::TEXT,
+		    t.full_name,
+		    GREATEST(
+		        word_similarity(q.text, f_unaccent(lower(h.full_name))),
+		        word_similarity(q.text, f_unaccent(lower(h.medium_name))),
+		        COALESCE(word_similarity(q.text, f_unaccent(lower(h.nickname))), 0)
+		    ) + CASE WHEN v.doc @@ q.query THEN 0.5 ELSE 0 END,
+		    ts_headline('sportech_search', h.full_name || COALESCE(' (' || h.nickname || ')', ''), q.query, q.options)
+	    FROM team_names h
+	    JOIN teams t ON t.id = h.team_id
+	    CROSS JOIN q
+	    CROSS JOIN LATERAL (
+	        SELECT to_tsvector('sportech_search', h.full_name || ' ' || h.medium_name || ' ' || coalesce(h.nickname, '') || ' ' || h.acronym) AS doc
+	    ) v
+	    WHERE q.text <% f_unaccent(lower(h.full_name))
+	       OR v.doc @@ q.query
+	`},
+	TypePerson: {`
+	    SELECT
+		    'person',
+		    p.id::TEXT,
+		    COALESCE(p.known_as, p.first_name || ' ' || p.last_name),
+		    GREATEST(
+		        word_similarity(q.text, f_unaccent(lower(p.first_name || ' ' || coalesce(p.middle_names || ' ', '') || p.last_name))),
+		        COALESCE(word_similarity(q.text, f_unaccent(lower(p.known_as))), 0)
+		    ) + CASE WHEN v.doc @@ q.query THEN 0.5 ELSE 0 END,
+		    ts_headline('sportech_search', COALESCE(p.known_as || ' (', '') || p.first_name || ' ' || coalesce(p.middle_names || ' ', '') || p.last_name || CASE WHEN p.known_as IS NULL THEN '' ELSE ')' END, q.query, q.options)
+	    FROM persons p
+	    CROSS JOIN q
+	    CROSS JOIN LATERAL (
+	        SELECT to_tsvector('sportech_search', p.first_name || ' ' || coalesce(p.middle_names || ' ', '') || p.last_name || ' ' || coalesce(p.known_as, '')) AS doc
+	    ) v
+	    WHERE q.text <% f_unaccent(lower(p.first_name || ' ' || coalesce(p.middle_names || ' ', '') || p.last_name))
+	       OR q.text <% f_unaccent(lower(p.known_as))
+	       OR v.doc @@ q.query
+	`, `
+	    SELECT
+		    'person',
+		    p.id::TEXT,
+		    COALESCE(p.known_as, p.first_name || ' ' || p.last_name),
+		    word_similarity(q.text, f_unaccent(lower(a.alias))) + CASE WHEN v.doc @@ q.query THEN 0.5 ELSE 0 END,
+		    ts_headline('sportech_search', a.alias, q.query, q.options)
+	    FROM person_aliases a
+	    JOIN persons p ON p.id = a.person_id
+	    CROSS JOIN q
+	    CROSS JOIN LATERAL (SELECT to_tsvector('sportech_search', a.alias) AS doc) v
+	    WHERE q.text <% f_unaccent(lower(a.alias))
+	       OR v.doc @@ q.query
+	`},
+	TypeStadium: {`
+	    SELECT
+		    'stadium',
+		    s.id::TEXT,
+		    s.name,
+		    word_similarity(q.text, f_unaccent(lower(s.name))) + CASE WHEN v.doc @@ q.query THEN 0.5 ELSE 0 END,
+		    ts_headline('sportech_search', s.name, q.query, q.options)
+	    FROM stadiums s
+	    CROSS JOIN q
+	    CROSS JOIN LATERAL (SELECT to_tsvector('sportech_search', s.name) AS doc) v
+	    WHERE q.text <% f_unaccent(lower(s.name))
+	       OR v.doc @@ q.query
+	`, `
+	    SELECT
+		    'stadium',
+		    s.id::TEXT,
+		    s.name,
+		    word_similarity(q.text, f_unaccent(lower(h.name))) + CASE WHEN v.doc @@ q.query THEN 0.5 ELSE 0 END,
+		    ts_headline('sportech_search', h.name, q.query, q.options)
+	    FROM stadium_names h
+	    JOIN stadiums s ON s.id = h.stadium_id
+	    CROSS JOIN q
+	    CROSS JOIN LATERAL (SELECT to_tsvector('sportech_search', h.name) AS doc) v
+	    WHERE q.text <% f_unaccent(lower(h.name))
+	       OR v.doc @@ q.query
+	`},
+	TypeLeague: {`
+	    SELECT
+		    'league',
+		    l.id::TEXT,
+		    l.name,
+		    word_similarity(q.text, f_unaccent(lower(l.name))) + CASE WHEN v.doc @@ q.query THEN 0.5 ELSE 0 END,
+		    ts_headline('sportech_search', l.name, q.query, q.options)
+	    FROM leagues l
+	    CROSS JOIN q
+	    CROSS JOIN LATERAL (SELECT to_tsvector('sportech_search', l.name) AS doc) v
+	    WHERE q.text <% f_unaccent(lower(l.name))
+	       OR v.doc @@ q.query
+	`},
+}
+
+func (r *repository) Search(ctx context.Context, text string, prefixQuery string, types []string, limit int) ([]Result, error) {
+	var selects []string
+	for _, t := range types {
+		selects = append(selects, branches[t]...)
+	}
+	if len(selects) == 0 {
+		return nil, nil
+	}
+
+	// A match on both a current and a former name should only be returned once, with its best score.
+	query := fmt.Sprintf(`
+	    WITH q AS (
+	        SELECT
+	            f_unaccent(lower($1)) AS text,
+	            to_tsquery('sportech_search', $2) AS query,
+	            'StartSel=' || chr(2) || ', StopSel=' || chr(3) || ', HighlightAll=true' AS options
+	    )
+	    SELECT type, id, name, score, highlight
+	    FROM (
+	        SELECT DISTINCT ON (type, id) type, id, name, score, highlight
+	        FROM (%s) AS hits (type, id, name, score, highlight)
+	        ORDER BY type, id, score DESC
+	    ) AS best
+	    ORDER BY score DESC, name ASC
+	    LIMIT $3
+	`, strings.Join(selects, " UNION ALL "))
+
+	rows, err := r.pool.Query(ctx, query, text, prefixQuery, limit)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error searching for %s", text)
+	}
+	defer rows.Close()
+
+	var results []Result
+	for rows.Next() {
+		result := Result{}
+		if err := rows.Scan(
+			&result.Type,
+			&result.ID,
+			&result.Name,
+			&result.Score,
+			&result.Highlight,
+		); err != nil {
+			return nil, errors.Wrap(err, "error scanning row from database")
+		}
+		result.Highlight = highlight(result.Highlight)
+		results = append(results, result)
+	}
+	return results, rows.Err()
+}
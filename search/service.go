@@ -0,0 +1,66 @@
+package search
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"strings"
+	"unicode"
+)
+
+const (
+	DefaultLimit = 20
+	MaxLimit     = 100
+)
+
+var ErrInvalidArgument = errors.New("invalid argument")
+
+type Service interface {
+	Search(ctx context.Context, q string, types []string, limit int) ([]Result, error)
+}
+
+func NewService(repository Repository) Service {
+	return &service{repository: repository}
+}
+
+type service struct {
+	repository Repository
+}
+
+func (s *service) Search(ctx context.Context, q string, types []string, limit int) ([]Result, error) {
+	q = strings.TrimSpace(q)
+	prefixQuery := buildPrefixQuery(q)
+	if prefixQuery == "" {
+		return nil, errors.Wrapf(ErrInvalidArgument, "search query %q has no words in it", q)
+	}
+
+	if len(types) == 0 {
+		types = AllTypes
+	}
+	for _, t := range types {
+		if _, ok := branches[t]; !ok {
+			return nil, errors.Wrapf(ErrInvalidArgument, "unknown search type %q", t)
+		}
+	}
+
+	if limit <= 0 {
+		limit = DefaultLimit
+	}
+	if limit > MaxLimit {
+		limit = MaxLimit
+	}
+
+	return s.repository.Search(ctx, q, prefixQuery, types, limit)
+}
+
+// buildPrefixQuery turns free text into a tsquery where every word must prefix-match, e.g.
+// "Mo Salah" becomes "mo:* & salah:*". Anything other than letters and digits is dropped, so the
+// result is always valid tsquery syntax.
+func buildPrefixQuery(q string) string {
+	words := strings.FieldsFunc(strings.ToLower(q), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for i, word := range words {
+		words[i] = word + ":*"
+	}
+	return strings.Join(words, " & ")
+}
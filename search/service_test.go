@@ -0,0 +1,20 @@
+package search
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestBuildPrefixQuery(t *testing.T) {
+	require.Equal(t, "mo:* & salah:*", buildPrefixQuery("Mo Salah"))
+	require.Equal(t, "spurs:*", buildPrefixQuery("  Spurs! "))
+	require.Equal(t, "o:* & higgins:*", buildPrefixQuery("O'Higgins"))
+	require.Equal(t, "mané:*", buildPrefixQuery("Mané"))
+	require.Equal(t, "", buildPrefixQuery("&|!:*()"))
+}
+
+func TestHighlight(t *testing.T) {
+	require.Equal(t, "<mark>Spurs</mark>", highlight("\x02Spurs\x03"))
+	require.Equal(t, "<mark>Spurs</mark> &lt;script&gt;", highlight("\x02Spurs\x03 <script>"))
+	require.Equal(t, "&lt;mark&gt;Spurs&lt;/mark&gt;", highlight("<mark>Spurs</mark>"))
+}
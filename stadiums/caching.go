@@ -0,0 +1,79 @@
+package stadiums
+
+import (
+	"context"
+	"github.com/rchauhan9/sportech/cache"
+	"github.com/rchauhan9/sportech/stream"
+	"time"
+)
+
+// NewCachingService serves reads from store, falling through to next on a miss. Streams are
+// served from the cached list, as the stadiums fit comfortably in memory.
+func NewCachingService(next Service, store *cache.Store) Service {
+	return &cachingService{next: next, store: store}
+}
+
+type cachingService struct {
+	next  Service
+	store *cache.Store
+}
+
+func (s *cachingService) ListStadiums(ctx context.Context) ([]Stadium, error) {
+	return cache.Load(s.store, cache.Key("ListStadiums"), func() ([]Stadium, error) {
+		return s.next.ListStadiums(ctx)
+	})
+}
+
+func (s *cachingService) ListStadiumsNear(ctx context.Context, point Point, radiusKm *float64) ([]Stadium, error) {
+	return cache.Load(s.store, cache.Key("ListStadiumsNear", point, radiusKm), func() ([]Stadium, error) {
+		return s.next.ListStadiumsNear(ctx, point, radiusKm)
+	})
+}
+
+func (s *cachingService) FindStadiumsByName(ctx context.Context, name string) ([]Stadium, error) {
+	return cache.Load(s.store, cache.Key("FindStadiumsByName", name), func() ([]Stadium, error) {
+		return s.next.FindStadiumsByName(ctx, name)
+	})
+}
+
+func (s *cachingService) StreamStadiums(ctx context.Context, yield func(Stadium) error) error {
+	stadiums, err := s.ListStadiums(ctx)
+	if err != nil {
+		return err
+	}
+	return stream.Slice(stadiums)(ctx, yield)
+}
+
+func (s *cachingService) StreamStadiumsNear(ctx context.Context, point Point, radiusKm *float64, yield func(Stadium) error) error {
+	stadiums, err := s.ListStadiumsNear(ctx, point, radiusKm)
+	if err != nil {
+		return err
+	}
+	return stream.Slice(stadiums)(ctx, yield)
+}
+
+func (s *cachingService) StreamStadiumsByName(ctx context.Context, name string, yield func(Stadium) error) error {
+	stadiums, err := s.FindStadiumsByName(ctx, name)
+	if err != nil {
+		return err
+	}
+	return stream.Slice(stadiums)(ctx, yield)
+}
+
+func (s *cachingService) GetStadium(ctx context.Context, id string, asOf time.Time) (Stadium, error) {
+	return cache.Load(s.store, cache.Key("GetStadium", id, asOf), func() (Stadium, error) {
+		return s.next.GetStadium(ctx, id, asOf)
+	})
+}
+
+func (s *cachingService) GetStadiums(ctx context.Context, ids []string, asOf time.Time) (map[string]Stadium, error) {
+	return cache.LoadEach(s.store, cache.Key("GetStadiums", asOf), ids, func(missing []string) (map[string]Stadium, error) {
+		return s.next.GetStadiums(ctx, missing, asOf)
+	})
+}
+
+func (s *cachingService) ListStadiumTenants(ctx context.Context, id string, asOf time.Time) ([]Tenant, error) {
+	return cache.Load(s.store, cache.Key("ListStadiumTenants", id, asOf), func() ([]Tenant, error) {
+		return s.next.ListStadiumTenants(ctx, id, asOf)
+	})
+}
@@ -3,34 +3,72 @@ package stadiums
 import (
 	"context"
 	"github.com/go-kit/kit/endpoint"
+	"github.com/rchauhan9/sportech/stream"
+	"time"
 )
 
-type listStadiumsRequest struct{}
+// formatGeoJSON is offered alongside the stream formats by the stadium list.
+const formatGeoJSON = "geojson"
+
+type listStadiumsRequest struct {
+	Name     string
+	Near     *Point
+	RadiusKm *float64
+	Format   string
+}
 
 type listStadiumsResponse struct {
-	Stadiums []Stadium `json:"stadiums"`
+	Stadiums stream.Stream[Stadium] `json:"stadiums"`
+	format   string
 }
 
 type getStadiumRequest struct {
-	ID string
+	ID   string
+	AsOf time.Time
 }
 
 type getStadiumResponse struct {
 	Stadium Stadium `json:"stadium"`
 }
 
+type listStadiumTenantsRequest struct {
+	ID     string
+	AsOf   time.Time
+	Format string
+}
+
+type listStadiumTenantsResponse struct {
+	Tenants stream.Stream[Tenant] `json:"tenants"`
+	format  string
+}
+
 func MakeListStadiumsEndpoint(svc Service) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
-		_ = request.(listStadiumsRequest)
-		Stadiums, err := svc.ListStadiums(ctx)
-		return listStadiumsResponse{Stadiums: Stadiums}, err
+		req := request.(listStadiumsRequest)
+		stadiums := func(ctx context.Context, yield func(Stadium) error) error {
+			if req.Name != "" {
+				return svc.StreamStadiumsByName(ctx, req.Name, yield)
+			} else if req.Near != nil {
+				return svc.StreamStadiumsNear(ctx, *req.Near, req.RadiusKm, yield)
+			}
+			return svc.StreamStadiums(ctx, yield)
+		}
+		return listStadiumsResponse{Stadiums: stadiums, format: req.Format}, nil
 	}
 }
 
 func MakeGetStadiumEndpoint(svc Service) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
 		req := request.(getStadiumRequest)
-		Stadium, err := svc.GetStadium(ctx, req.ID)
+		Stadium, err := svc.GetStadium(ctx, req.ID, req.AsOf)
 		return getStadiumResponse{Stadium: Stadium}, err
 	}
 }
+
+func MakeListStadiumTenantsEndpoint(svc Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(listStadiumTenantsRequest)
+		tenants, err := svc.ListStadiumTenants(ctx, req.ID, req.AsOf)
+		return listStadiumTenantsResponse{Tenants: stream.Slice(tenants), format: req.Format}, err
+	}
+}
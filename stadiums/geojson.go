@@ -0,0 +1,39 @@
+package stadiums
+
+// FeatureCollection is a GeoJSON (RFC 7946) rendering of a list of stadiums, for map clients.
+type FeatureCollection struct {
+	Type     string    `json:"type"`
+	Features []Feature `json:"features"`
+}
+
+type Feature struct {
+	Type       string    `json:"type"`
+	ID         string    `json:"id"`
+	Geometry   *Geometry `json:"geometry"`
+	Properties Stadium   `json:"properties"`
+}
+
+type Geometry struct {
+	Type        string    `json:"type"`
+	Coordinates []float64 `json:"coordinates"`
+}
+
+// NewFeatureCollection converts stadiums into GeoJSON features. Stadiums without a location
+// are kept with a null geometry, as the spec allows.
+func NewFeatureCollection(stadiums []Stadium) FeatureCollection {
+	features := make([]Feature, len(stadiums))
+	for i, stadium := range stadiums {
+		features[i] = Feature{
+			Type:       "Feature",
+			ID:         stadium.ID,
+			Properties: stadium,
+		}
+		if stadium.Latitude != nil && stadium.Longitude != nil {
+			features[i].Geometry = &Geometry{
+				Type:        "Point",
+				Coordinates: []float64{*stadium.Longitude, *stadium.Latitude},
+			}
+		}
+	}
+	return FeatureCollection{Type: "FeatureCollection", Features: features}
+}
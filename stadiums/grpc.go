@@ -0,0 +1,165 @@
+package stadiums
+
+import (
+	"context"
+	"github.com/go-kit/kit/endpoint"
+	grpctransport "github.com/go-kit/kit/transport/grpc"
+	"github.com/pkg/errors"
+	"github.com/rchauhan9/sportech/auth"
+	"github.com/rchauhan9/sportech/commons/go/grpcutil"
+	"github.com/rchauhan9/sportech/commons/go/queryutil"
+	pb "github.com/rchauhan9/sportech/pb/sportech/v1"
+	"github.com/rchauhan9/sportech/stream"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type grpcServer struct {
+	pb.UnimplementedStadiumServiceServer
+	listStadiums       grpctransport.Handler
+	getStadium         grpctransport.Handler
+	listStadiumTenants grpctransport.Handler
+}
+
+func MakeGRPCServer(listStadiumsEndpoint endpoint.Endpoint, getStadiumEndpoint endpoint.Endpoint, listStadiumTenantsEndpoint endpoint.Endpoint) pb.StadiumServiceServer {
+	return &grpcServer{
+		listStadiums: grpctransport.NewServer(
+			listStadiumsEndpoint,
+			decodeGRPCListStadiumsRequest,
+			encodeGRPCListStadiumsResponse,
+		),
+		getStadium: grpctransport.NewServer(
+			getStadiumEndpoint,
+			decodeGRPCGetStadiumRequest,
+			encodeGRPCGetStadiumResponse,
+		),
+		listStadiumTenants: grpctransport.NewServer(
+			listStadiumTenantsEndpoint,
+			decodeGRPCListStadiumTenantsRequest,
+			encodeGRPCListStadiumTenantsResponse,
+		),
+	}
+}
+
+func (s *grpcServer) ListStadiums(ctx context.Context, req *pb.ListStadiumsRequest) (*pb.ListStadiumsResponse, error) {
+	_, rep, err := s.listStadiums.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeGRPCError(err)
+	}
+	return rep.(*pb.ListStadiumsResponse), nil
+}
+
+func (s *grpcServer) GetStadium(ctx context.Context, req *pb.GetStadiumRequest) (*pb.GetStadiumResponse, error) {
+	_, rep, err := s.getStadium.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeGRPCError(err)
+	}
+	return rep.(*pb.GetStadiumResponse), nil
+}
+
+func (s *grpcServer) ListStadiumTenants(ctx context.Context, req *pb.ListStadiumTenantsRequest) (*pb.ListStadiumTenantsResponse, error) {
+	_, rep, err := s.listStadiumTenants.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeGRPCError(err)
+	}
+	return rep.(*pb.ListStadiumTenantsResponse), nil
+}
+
+func decodeGRPCListStadiumsRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.ListStadiumsRequest)
+	listReq := listStadiumsRequest{Name: req.Name, RadiusKm: req.RadiusKm, Format: stream.FormatJSON}
+	if req.Near != nil {
+		if req.Name != "" {
+			return nil, errors.Wrap(ErrInvalidArgument, "name and near cannot be combined")
+		}
+		listReq.Near = &Point{Latitude: req.Near.Latitude, Longitude: req.Near.Longitude}
+	}
+	if req.RadiusKm != nil && req.Near == nil {
+		return nil, errors.Wrap(ErrInvalidArgument, "radius_km requires near")
+	}
+	return listReq, nil
+}
+
+func encodeGRPCListStadiumsResponse(ctx context.Context, response interface{}) (interface{}, error) {
+	resp := response.(listStadiumsResponse)
+	var stadiums []*pb.Stadium
+	if err := resp.Stadiums(ctx, func(stadium Stadium) error {
+		stadiums = append(stadiums, encodeGRPCStadium(stadium))
+		return nil
+	}); err != nil {
+		return nil, encodeGRPCError(err)
+	}
+	return &pb.ListStadiumsResponse{Stadiums: stadiums}, nil
+}
+
+func decodeGRPCGetStadiumRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.GetStadiumRequest)
+	asOf, err := queryutil.Date("asOf", req.AsOf, ErrInvalidArgument)
+	if err != nil {
+		return nil, err
+	}
+	return getStadiumRequest{ID: req.Id, AsOf: asOf}, nil
+}
+
+func encodeGRPCGetStadiumResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(getStadiumResponse)
+	return &pb.GetStadiumResponse{Stadium: encodeGRPCStadium(resp.Stadium)}, nil
+}
+
+func decodeGRPCListStadiumTenantsRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.ListStadiumTenantsRequest)
+	asOf, err := queryutil.Date("asOf", req.AsOf, ErrInvalidArgument)
+	if err != nil {
+		return nil, err
+	}
+	return listStadiumTenantsRequest{ID: req.Id, AsOf: asOf}, nil
+}
+
+func encodeGRPCListStadiumTenantsResponse(ctx context.Context, response interface{}) (interface{}, error) {
+	resp := response.(listStadiumTenantsResponse)
+	collected, err := resp.Tenants.Collect(ctx)
+	if err != nil {
+		return nil, encodeGRPCError(err)
+	}
+	tenants := make([]*pb.Tenant, len(collected))
+	for i, tenant := range collected {
+		tenants[i] = &pb.Tenant{
+			Id:      tenant.ID,
+			Team:    tenant.Team,
+			Started: timestamppb.New(tenant.Started),
+			Ended:   grpcutil.OptionalTimestamp(tenant.Ended),
+		}
+	}
+	return &pb.ListStadiumTenantsResponse{Tenants: tenants}, nil
+}
+
+func encodeGRPCStadium(stadium Stadium) *pb.Stadium {
+	return &pb.Stadium{
+		Id:         stadium.ID,
+		Name:       stadium.Name,
+		Capacity:   stadium.Capacity,
+		City:       stadium.City,
+		Country:    stadium.Country,
+		Latitude:   stadium.Latitude,
+		Longitude:  stadium.Longitude,
+		Elevation:  stadium.Elevation,
+		Surface:    stadium.Surface,
+		YearOpened: stadium.YearOpened,
+		DistanceKm: stadium.DistanceKm,
+	}
+}
+
+// encode errors from business-logic as gRPC statuses
+func encodeGRPCError(err error) error {
+	switch errors.Cause(err) {
+	case ErrInvalidArgument:
+		return status.Error(codes.InvalidArgument, err.Error())
+	case auth.ErrUnauthenticated:
+		return status.Error(codes.Unauthenticated, err.Error())
+	case auth.ErrForbidden:
+		return status.Error(codes.PermissionDenied, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
@@ -7,24 +7,41 @@ import (
 	kithttp "github.com/go-kit/kit/transport/http"
 	"github.com/gorilla/mux"
 	"github.com/pkg/errors"
+	"github.com/rchauhan9/sportech/auth"
+	"github.com/rchauhan9/sportech/commons/go/logging"
+	"github.com/rchauhan9/sportech/commons/go/queryutil"
+	"github.com/rchauhan9/sportech/stream"
+	"math"
 	"net/http"
+	"strconv"
+	"strings"
 )
 
-func MakeHandler(listStadiumsEndpoint endpoint.Endpoint, getStadiumEndpoint endpoint.Endpoint) http.Handler {
+func MakeHandler(listStadiumsEndpoint endpoint.Endpoint, getStadiumEndpoint endpoint.Endpoint, listStadiumTenantsEndpoint endpoint.Endpoint) http.Handler {
 	r := mux.NewRouter()
 
 	listStadiumsHandler := kithttp.NewServer(
 		listStadiumsEndpoint,
 		decodeListStadiumsRequest,
 		encodeListStadiumsResponse,
+		kithttp.ServerErrorEncoder(encodeError),
 	)
 
 	getStadiumHandler := kithttp.NewServer(
 		getStadiumEndpoint,
 		decodeGetStadiumRequest,
 		encodeGetStadiumResponse,
+		kithttp.ServerErrorEncoder(encodeError),
 	)
 
+	listStadiumTenantsHandler := kithttp.NewServer(
+		listStadiumTenantsEndpoint,
+		decodeListStadiumTenantsRequest,
+		encodeListStadiumTenantsResponse,
+		kithttp.ServerErrorEncoder(encodeError),
+	)
+
+	r.Handle("/stadiums/{id}/tenants", listStadiumTenantsHandler).Methods("GET")
 	r.Handle("/stadiums/{id}", getStadiumHandler).Methods("GET")
 	r.Handle("/stadiums/", listStadiumsHandler).Methods("GET")
 
@@ -32,8 +49,71 @@ func MakeHandler(listStadiumsEndpoint endpoint.Endpoint, getStadiumEndpoint endp
 }
 
 func decodeListStadiumsRequest(_ context.Context, r *http.Request) (interface{}, error) {
-	_ = mux.Vars(r)
-	return listStadiumsRequest{}, nil
+	query := r.URL.Query()
+	req := listStadiumsRequest{Name: query.Get("name")}
+
+	if near := query.Get("near"); near != "" {
+		point, err := parsePoint(near)
+		if err != nil {
+			return nil, err
+		}
+		if req.Name != "" {
+			return nil, errors.Wrap(ErrInvalidArgument, "name and near cannot be combined")
+		}
+		req.Near = &point
+	}
+
+	if radius := query.Get("radiusKm"); radius != "" {
+		if req.Near == nil {
+			return nil, errors.Wrap(ErrInvalidArgument, "radiusKm requires near")
+		}
+		radiusKm, err := parseFinite(radius)
+		if err != nil {
+			return nil, errors.Wrapf(ErrInvalidArgument, "invalid radiusKm %q", radius)
+		}
+		req.RadiusKm = &radiusKm
+	}
+
+	if query.Get("format") == formatGeoJSON {
+		req.Format = formatGeoJSON
+		return req, nil
+	}
+	format, err := queryutil.Format(r, ErrInvalidArgument)
+	if err != nil {
+		return nil, err
+	}
+	req.Format = format
+
+	return req, nil
+}
+
+// parsePoint parses a "lat,lng" pair.
+func parsePoint(s string) (Point, error) {
+	parts := strings.Split(s, ",")
+	if len(parts) != 2 {
+		return Point{}, errors.Wrapf(ErrInvalidArgument, "invalid near %q, expected lat,lng", s)
+	}
+	lat, err := parseFinite(parts[0])
+	if err != nil {
+		return Point{}, errors.Wrapf(ErrInvalidArgument, "invalid latitude in near %q", s)
+	}
+	lng, err := parseFinite(parts[1])
+	if err != nil {
+		return Point{}, errors.Wrapf(ErrInvalidArgument, "invalid longitude in near %q", s)
+	}
+	return Point{Latitude: lat, Longitude: lng}, nil
+}
+
+// parseFinite parses a float, rejecting NaN and infinities, which strconv accepts.
+func parseFinite(s string) (float64, error) {
+	f, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+	if err != nil {
+		return 0, err
+	}
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return 0, errors.Errorf("%q is not a finite number", s)
+	}
+	return f, nil
 }
 
 func encodeListStadiumsResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
@@ -41,7 +121,16 @@ func encodeListStadiumsResponse(ctx context.Context, w http.ResponseWriter, resp
 		encodeError(ctx, e.error(), w)
 		return nil
 	}
-	return json.NewEncoder(w).Encode(response)
+	resp := response.(listStadiumsResponse)
+	if resp.format == formatGeoJSON {
+		stadiums, err := resp.Stadiums.Collect(ctx)
+		if err != nil {
+			return err
+		}
+		w.Header().Set("Content-Type", "application/geo+json; charset=utf-8")
+		return json.NewEncoder(w).Encode(NewFeatureCollection(stadiums))
+	}
+	return stream.Encode(ctx, w, resp.format, "stadiums", resp.Stadiums)
 }
 
 func decodeGetStadiumRequest(_ context.Context, r *http.Request) (interface{}, error) {
@@ -50,7 +139,11 @@ func decodeGetStadiumRequest(_ context.Context, r *http.Request) (interface{}, e
 	if !ok {
 		return nil, errors.New("bad route")
 	}
-	return getStadiumRequest{ID: id}, nil
+	asOf, err := queryutil.AsOf(r, ErrInvalidArgument)
+	if err != nil {
+		return nil, err
+	}
+	return getStadiumRequest{ID: id, AsOf: asOf}, nil
 }
 
 func encodeGetStadiumResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
@@ -61,18 +154,47 @@ func encodeGetStadiumResponse(ctx context.Context, w http.ResponseWriter, respon
 	return json.NewEncoder(w).Encode(response)
 }
 
+func decodeListStadiumTenantsRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	vars := mux.Vars(r)
+	id, ok := vars["id"]
+	if !ok {
+		return nil, errors.New("bad route")
+	}
+	asOf, err := queryutil.AsOf(r, ErrInvalidArgument)
+	if err != nil {
+		return nil, err
+	}
+	format, err := queryutil.Format(r, ErrInvalidArgument)
+	if err != nil {
+		return nil, err
+	}
+	return listStadiumTenantsRequest{ID: id, AsOf: asOf, Format: format}, nil
+}
+
+func encodeListStadiumTenantsResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	if e, ok := response.(errorer); ok && e.error() != nil {
+		encodeError(ctx, e.error(), w)
+		return nil
+	}
+	resp := response.(listStadiumTenantsResponse)
+	return stream.Encode(ctx, w, resp.format, "tenants", resp.Tenants)
+}
+
 type errorer interface {
 	error() error
 }
 
 // encode errors from business-logic
-func encodeError(_ context.Context, err error, w http.ResponseWriter) {
+func encodeError(ctx context.Context, err error, w http.ResponseWriter) {
+	logging.RecordError(ctx, err)
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
-	switch err {
-	//case erro:
-	//	w.WriteHeader(http.StatusNotFound)
-	//case ErrInvalidArgument:
-	//	w.WriteHeader(http.StatusBadRequest)
+	switch errors.Cause(err) {
+	case ErrInvalidArgument:
+		w.WriteHeader(http.StatusBadRequest)
+	case auth.ErrUnauthenticated:
+		w.WriteHeader(http.StatusUnauthorized)
+	case auth.ErrForbidden:
+		w.WriteHeader(http.StatusForbidden)
 	default:
 		w.WriteHeader(http.StatusInternalServerError)
 	}
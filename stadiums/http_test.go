@@ -0,0 +1,30 @@
+package stadiums
+
+import (
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"math"
+	"testing"
+)
+
+func TestParsePoint(t *testing.T) {
+	point, err := parsePoint("53.4308, -2.9608")
+	require.NoError(t, err)
+	require.Equal(t, Point{Latitude: 53.4308, Longitude: -2.9608}, point)
+
+	for _, near := range []string{"NaN,0", "0,NaN", "Inf,0", "0,-Inf", "53.4308", "north,west"} {
+		_, err := parsePoint(near)
+		require.True(t, errors.Is(err, ErrInvalidArgument), near)
+	}
+}
+
+func TestValidateNearRejectsNonFinite(t *testing.T) {
+	nan, inf := math.NaN(), math.Inf(1)
+	require.True(t, errors.Is(validateNear(Point{Latitude: nan}, nil), ErrInvalidArgument))
+	require.True(t, errors.Is(validateNear(Point{Longitude: nan}, nil), ErrInvalidArgument))
+	require.True(t, errors.Is(validateNear(Point{}, &nan), ErrInvalidArgument))
+	require.True(t, errors.Is(validateNear(Point{}, &inf), ErrInvalidArgument))
+
+	radiusKm := 100.0
+	require.NoError(t, validateNear(Point{Latitude: 53.4308, Longitude: -2.9608}, &radiusKm))
+}
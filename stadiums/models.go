@@ -1,9 +1,31 @@
 package stadiums
 
+import "time"
+
 type Stadium struct {
-	ID       string `json:"id"`
-	Name     string `json:"name"`
-	Capacity int32  `json:"capacity"`
-	City     string `json:"city"`
-	Country  string `json:"country"`
+	ID         string   `json:"id"`
+	Name       string   `json:"name"`
+	Capacity   int32    `json:"capacity"`
+	City       string   `json:"city"`
+	Country    string   `json:"country"`
+	Latitude   *float64 `json:"latitude"`
+	Longitude  *float64 `json:"longitude"`
+	Elevation  *int32   `json:"elevation"`
+	Surface    *string  `json:"surface"`
+	YearOpened *int32   `json:"yearOpened"`
+	DistanceKm *float64 `json:"distanceKm,omitempty"`
+}
+
+type Point struct {
+	Latitude  float64
+	Longitude float64
+}
+
+// Tenant is a team that plays, or played, its home games at a stadium. Ended is the last day of
+// the tenancy, or nil if it is ongoing.
+type Tenant struct {
+	ID      string     `json:"id"`
+	Team    string     `json:"team"`
+	Started time.Time  `json:"started"`
+	Ended   *time.Time `json:"ended"`
 }
@@ -0,0 +1,41 @@
+package stadiums
+
+import (
+	"github.com/rchauhan9/sportech/openapi"
+	"net/http"
+)
+
+var Routes = []openapi.Route{
+	{
+		Method:      http.MethodGet,
+		Path:        "/stadiums/",
+		Tag:         "stadiums",
+		Summary:     "List stadiums",
+		Description: "With format=geojson the stadiums are returned as a GeoJSON FeatureCollection instead.",
+		Query: []openapi.Parameter{
+			openapi.QueryParam("name", "string", "Only return stadiums with this current or former name."),
+			openapi.QueryParam("near", "string", "A lat,lng point; stadiums are ordered by distance from it."),
+			openapi.QueryParam("radiusKm", "number", "Only return stadiums within this many kilometres of near."),
+			openapi.QueryParam("format", "string", "json (the default), csv, ndjson or geojson. Overrides the Accept header."),
+		},
+		Response: listStadiumsResponse{},
+		Formats:  true,
+	},
+	{
+		Method:   http.MethodGet,
+		Path:     "/stadiums/{id}",
+		Tag:      "stadiums",
+		Summary:  "Get a stadium",
+		Query:    []openapi.Parameter{openapi.AsOfParam},
+		Response: getStadiumResponse{},
+	},
+	{
+		Method:   http.MethodGet,
+		Path:     "/stadiums/{id}/tenants",
+		Tag:      "stadiums",
+		Summary:  "List the teams that play at a stadium",
+		Query:    []openapi.Parameter{openapi.AsOfParam, openapi.FormatParam},
+		Response: listStadiumTenantsResponse{},
+		Formats:  true,
+	},
+}
@@ -4,11 +4,22 @@ import (
 	"context"
 	"github.com/jackc/pgx/v4/pgxpool"
 	"github.com/pkg/errors"
+	"time"
 )
 
+// Tables lists the tables stadiums and their tenants are read from.
+var Tables = []string{"stadiums", "stadium_names", "stadium_capacities", "team_stadium_tenancies"}
+
 type Repository interface {
 	ListStadiums(ctx context.Context) ([]Stadium, error)
-	GetStadium(ctx context.Context, id string) (Stadium, error)
+	ListStadiumsNear(ctx context.Context, point Point, radiusKm *float64) ([]Stadium, error)
+	FindStadiumsByName(ctx context.Context, name string) ([]Stadium, error)
+	StreamStadiums(ctx context.Context, yield func(Stadium) error) error
+	StreamStadiumsNear(ctx context.Context, point Point, radiusKm *float64, yield func(Stadium) error) error
+	StreamStadiumsByName(ctx context.Context, name string, yield func(Stadium) error) error
+	GetStadium(ctx context.Context, id string, asOf time.Time) (Stadium, error)
+	GetStadiums(ctx context.Context, ids []string, asOf time.Time) (map[string]Stadium, error)
+	ListStadiumTenants(ctx context.Context, id string, asOf time.Time) ([]Tenant, error)
 }
 
 func NewRepository(dbPool *pgxpool.Pool) Repository {
@@ -19,23 +30,28 @@ type repository struct {
 	pool *pgxpool.Pool
 }
 
-func (r *repository) ListStadiums(ctx context.Context) ([]Stadium, error) {
+func (r *repository) StreamStadiums(ctx context.Context, yield func(Stadium) error) error {
 	query := `
 		SELECT
 		    id,
 		    name,
 		    capacity,
 		    city,
-		    country_id
+		    country_id,
+		    latitude,
+		    longitude,
+		    elevation,
+		    surface,
+		    year_opened
 	    FROM stadiums
 	    ORDER BY name ASC
 	`
 	rows, err := r.pool.Query(ctx, query)
 	if err != nil {
-		return nil, errors.Wrap(err, "errpr fetching discover category details from database")
+		return errors.Wrap(err, "errpr fetching discover category details from database")
 	}
 
-	var stadiums []Stadium
+	defer rows.Close()
 	for rows.Next() {
 		stadium := Stadium{}
 		if err := rows.Scan(
@@ -44,26 +60,187 @@ func (r *repository) ListStadiums(ctx context.Context) ([]Stadium, error) {
 			&stadium.Capacity,
 			&stadium.City,
 			&stadium.Country,
+			&stadium.Latitude,
+			&stadium.Longitude,
+			&stadium.Elevation,
+			&stadium.Surface,
+			&stadium.YearOpened,
 		); err != nil {
-			return nil, errors.Wrap(err, "error scanning row from database")
+			return errors.Wrap(err, "error scanning row from database")
+		}
+		if err := yield(stadium); err != nil {
+			return err
 		}
+	}
+	return rows.Err()
+}
+
+func (r *repository) ListStadiums(ctx context.Context) ([]Stadium, error) {
+	var stadiums []Stadium
+	err := r.StreamStadiums(ctx, func(stadium Stadium) error {
 		stadiums = append(stadiums, stadium)
+		return nil
+	})
+	return stadiums, err
+}
+
+// StreamStadiumsNear orders located stadiums by great-circle (haversine) distance from point. When
+// radiusKm is set, a latitude bounding box is applied first so the location index can be used.
+func (r *repository) StreamStadiumsNear(ctx context.Context, point Point, radiusKm *float64, yield func(Stadium) error) error {
+	query := `
+		SELECT * FROM (
+		    SELECT
+		        id,
+		        name,
+		        capacity,
+		        city,
+		        country_id,
+		        latitude,
+		        longitude,
+		        elevation,
+		        surface,
+		        year_opened,
+		        6371.0 * 2 * ASIN(LEAST(1.0, SQRT(
+		            POWER(SIN(RADIANS(latitude - $1) / 2), 2) +
+		            COS(RADIANS($1)) * COS(RADIANS(latitude)) * POWER(SIN(RADIANS(longitude - $2) / 2), 2)
+		        ))) AS distance_km
+		    FROM stadiums
+		    WHERE latitude IS NOT NULL
+		      AND longitude IS NOT NULL
+		      AND ($3::DOUBLE PRECISION IS NULL OR latitude BETWEEN $1 - $3 / 111.045 AND $1 + $3 / 111.045)
+		) s
+		WHERE $3::DOUBLE PRECISION IS NULL OR distance_km <= $3
+		ORDER BY distance_km ASC, name ASC
+	`
+	rows, err := r.pool.Query(ctx, query, point.Latitude, point.Longitude, radiusKm)
+	if err != nil {
+		return errors.Wrap(err, "error fetching nearby stadiums from database")
+	}
+
+	defer rows.Close()
+	for rows.Next() {
+		stadium := Stadium{}
+		if err := rows.Scan(
+			&stadium.ID,
+			&stadium.Name,
+			&stadium.Capacity,
+			&stadium.City,
+			&stadium.Country,
+			&stadium.Latitude,
+			&stadium.Longitude,
+			&stadium.Elevation,
+			&stadium.Surface,
+			&stadium.YearOpened,
+			&stadium.DistanceKm,
+		); err != nil {
+			return errors.Wrap(err, "error scanning row from database")
+		}
+		if err := yield(stadium); err != nil {
+			return err
+		}
 	}
-	return stadiums, nil
+	return rows.Err()
+}
+
+func (r *repository) ListStadiumsNear(ctx context.Context, point Point, radiusKm *float64) ([]Stadium, error) {
+	var stadiums []Stadium
+	err := r.StreamStadiumsNear(ctx, point, radiusKm, func(stadium Stadium) error {
+		stadiums = append(stadiums, stadium)
+		return nil
+	})
+	return stadiums, err
 }
 
-func (r *repository) GetStadium(ctx context.Context, id string) (Stadium, error) {
+// StreamStadiumsByName matches name case-insensitively against both current and historical names.
+func (r *repository) StreamStadiumsByName(ctx context.Context, name string, yield func(Stadium) error) error {
 	query := `
-	    SELECT
+		SELECT
 		    id,
 		    name,
 		    capacity,
 		    city,
-		    country_id
-	    FROM stadiums
-	    WHERE id = $1
+		    country_id,
+		    latitude,
+		    longitude,
+		    elevation,
+		    surface,
+		    year_opened
+	    FROM stadiums s
+	    WHERE lower(s.name) = lower($1)
+	       OR EXISTS (SELECT 1 FROM stadium_names n WHERE n.stadium_id = s.id AND lower(n.name) = lower($1))
+	    ORDER BY name ASC
 	`
-	row := r.pool.QueryRow(ctx, query, id)
+	rows, err := r.pool.Query(ctx, query, name)
+	if err != nil {
+		return errors.Wrapf(err, "error finding stadiums named %s", name)
+	}
+
+	defer rows.Close()
+	for rows.Next() {
+		stadium := Stadium{}
+		if err := rows.Scan(
+			&stadium.ID,
+			&stadium.Name,
+			&stadium.Capacity,
+			&stadium.City,
+			&stadium.Country,
+			&stadium.Latitude,
+			&stadium.Longitude,
+			&stadium.Elevation,
+			&stadium.Surface,
+			&stadium.YearOpened,
+		); err != nil {
+			return errors.Wrap(err, "error scanning row from database")
+		}
+		if err := yield(stadium); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+func (r *repository) FindStadiumsByName(ctx context.Context, name string) ([]Stadium, error) {
+	var stadiums []Stadium
+	err := r.StreamStadiumsByName(ctx, name, func(stadium Stadium) error {
+		stadiums = append(stadiums, stadium)
+		return nil
+	})
+	return stadiums, err
+}
+
+// GetStadium returns the name and capacity that applied on asOf, falling back to the current
+// values when no history covers that date.
+func (r *repository) GetStadium(ctx context.Context, id string, asOf time.Time) (Stadium, error) {
+	query := `
+	    SELECT
+		    s.id,
+		    COALESCE(n.name, s.name),
+		    COALESCE(c.capacity, s.capacity),
+		    s.city,
+		    s.country_id,
+		    s.latitude,
+		    s.longitude,
+		    s.elevation,
+		    s.surface,
+		    s.year_opened
+	    FROM stadiums s
+	    LEFT JOIN LATERAL (
+	        SELECT name
+	        FROM stadium_names
+	        WHERE stadium_id = s.id AND started <= $2 AND (ended IS NULL OR ended >= $2)
+	        ORDER BY started DESC
+	        LIMIT 1
+	    ) n ON TRUE
+	    LEFT JOIN LATERAL (
+	        SELECT capacity
+	        FROM stadium_capacities
+	        WHERE stadium_id = s.id AND started <= $2 AND (ended IS NULL OR ended >= $2)
+	        ORDER BY started DESC
+	        LIMIT 1
+	    ) c ON TRUE
+	    WHERE s.id = $1
+	`
+	row := r.pool.QueryRow(ctx, query, id, asOf)
 	var stadium Stadium
 	if err := row.Scan(
 		&stadium.ID,
@@ -71,8 +248,106 @@ func (r *repository) GetStadium(ctx context.Context, id string) (Stadium, error)
 		&stadium.Capacity,
 		&stadium.City,
 		&stadium.Country,
+		&stadium.Latitude,
+		&stadium.Longitude,
+		&stadium.Elevation,
+		&stadium.Surface,
+		&stadium.YearOpened,
 	); err != nil {
 		return stadium, errors.Wrapf(err, "error getting stadium with id %s", id)
 	}
 	return stadium, nil
 }
+
+// GetStadiums looks up many stadiums as they were on asOf in one query, keyed by ID. IDs that
+// match no stadium are left out of the result.
+func (r *repository) GetStadiums(ctx context.Context, ids []string, asOf time.Time) (map[string]Stadium, error) {
+	query := `
+	    SELECT
+		    s.id,
+		    COALESCE(n.name, s.name),
+		    COALESCE(c.capacity, s.capacity),
+		    s.city,
+		    s.country_id,
+		    s.latitude,
+		    s.longitude,
+		    s.elevation,
+		    s.surface,
+		    s.year_opened
+	    FROM stadiums s
+	    LEFT JOIN LATERAL (
+	        SELECT name
+	        FROM stadium_names
+	        WHERE stadium_id = s.id AND started <= $2 AND (ended IS NULL OR ended >= $2)
+	        ORDER BY started DESC
+	        LIMIT 1
+	    ) n ON TRUE
+	    LEFT JOIN LATERAL (
+	        SELECT capacity
+	        FROM stadium_capacities
+	        WHERE stadium_id = s.id AND started <= $2 AND (ended IS NULL OR ended >= $2)
+	        ORDER BY started DESC
+	        LIMIT 1
+	    ) c ON TRUE
+	    WHERE s.id = ANY($1)
+	`
+	rows, err := r.pool.Query(ctx, query, ids, asOf)
+	if err != nil {
+		return nil, errors.Wrap(err, "error fetching stadiums from database")
+	}
+	defer rows.Close()
+
+	stadiums := make(map[string]Stadium, len(ids))
+	for rows.Next() {
+		stadium := Stadium{}
+		if err := rows.Scan(
+			&stadium.ID,
+			&stadium.Name,
+			&stadium.Capacity,
+			&stadium.City,
+			&stadium.Country,
+			&stadium.Latitude,
+			&stadium.Longitude,
+			&stadium.Elevation,
+			&stadium.Surface,
+			&stadium.YearOpened,
+		); err != nil {
+			return nil, errors.Wrap(err, "error scanning row from database")
+		}
+		stadiums[stadium.ID] = stadium
+	}
+	return stadiums, rows.Err()
+}
+
+func (r *repository) ListStadiumTenants(ctx context.Context, id string, asOf time.Time) ([]Tenant, error) {
+	query := `
+	    SELECT
+		    id,
+		    team_id,
+		    started,
+		    ended
+	    FROM team_stadium_tenancies
+	    WHERE stadium_id = $1 AND started <= $2 AND (ended IS NULL OR ended >= $2)
+	    ORDER BY started ASC
+	`
+	rows, err := r.pool.Query(ctx, query, id, asOf)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error fetching tenants for stadium with id %s", id)
+	}
+	defer rows.Close()
+
+	var tenants []Tenant
+	for rows.Next() {
+		tenant := Tenant{}
+		if err := rows.Scan(
+			&tenant.ID,
+			&tenant.Team,
+			&tenant.Started,
+			&tenant.Ended,
+		); err != nil {
+			return nil, errors.Wrap(err, "error scanning row from database")
+		}
+		tenants = append(tenants, tenant)
+	}
+	return tenants, rows.Err()
+}
@@ -14,6 +14,7 @@ import (
 	"gopkg.in/khaiql/dbcleaner.v2"
 	"gopkg.in/khaiql/dbcleaner.v2/engine"
 	"testing"
+	"time"
 )
 
 const (
@@ -63,11 +64,11 @@ func (suite *RepositoryTestSuite) TearDownSuite() {
 }
 
 func (suite *RepositoryTestSuite) SetupTest() {
-	suite.cleaner.Acquire("stadiums")
+	suite.cleaner.Acquire("stadiums", "stadium_names", "stadium_capacities", "team_stadium_tenancies")
 }
 
 func (suite *RepositoryTestSuite) TearDownTest() {
-	suite.cleaner.Clean("stadiums")
+	suite.cleaner.Clean("stadiums", "stadium_names", "stadium_capacities", "team_stadium_tenancies")
 }
 
 func TestRepositoryTestSuite(t *testing.T) {
@@ -99,7 +100,7 @@ func (suite *RepositoryTestSuite) TestGetStadium() {
 	england := uuid.New().String()
 	anfield := createStadium(suite, "Anfield", 54000, "Liverpool", england)
 
-	stad, err := suite.repository.GetStadium(suite.ctx, anfield.ID)
+	stad, err := suite.repository.GetStadium(suite.ctx, anfield.ID, time.Now())
 	require.NoError(suite.T(), err)
 
 	require.Equal(suite.T(), stad.ID, anfield.ID)
@@ -109,6 +110,113 @@ func (suite *RepositoryTestSuite) TestGetStadium() {
 	require.Equal(suite.T(), stad.Country, anfield.Country)
 }
 
+func (suite *RepositoryTestSuite) TestGetStadiumAsOf() {
+	england := uuid.New().String()
+	etihad := createStadium(suite, "Etihad Stadium", 53400, "Manchester", england)
+	cityOfManchesterEnded := time.Date(2011, time.July, 7, 0, 0, 0, 0, time.UTC)
+	createStadiumName(suite, etihad.ID, "City of Manchester Stadium", time.Date(2003, time.August, 10, 0, 0, 0, 0, time.UTC), &cityOfManchesterEnded)
+	createStadiumName(suite, etihad.ID, "Etihad Stadium", time.Date(2011, time.July, 8, 0, 0, 0, 0, time.UTC), nil)
+	originalCapacityEnded := time.Date(2015, time.May, 31, 0, 0, 0, 0, time.UTC)
+	createStadiumCapacity(suite, etihad.ID, 47726, time.Date(2003, time.August, 10, 0, 0, 0, 0, time.UTC), &originalCapacityEnded)
+
+	stad, err := suite.repository.GetStadium(suite.ctx, etihad.ID, time.Date(2010, time.January, 1, 0, 0, 0, 0, time.UTC))
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), "City of Manchester Stadium", stad.Name)
+	require.Equal(suite.T(), int32(47726), stad.Capacity)
+
+	stad, err = suite.repository.GetStadium(suite.ctx, etihad.ID, time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC))
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), "Etihad Stadium", stad.Name)
+	require.Equal(suite.T(), etihad.Capacity, stad.Capacity)
+}
+
+func (suite *RepositoryTestSuite) TestFindStadiumsByName() {
+	england := uuid.New().String()
+	etihad := createStadium(suite, "Etihad Stadium", 53400, "Manchester", england)
+	_ = createStadium(suite, "Anfield", 54000, "Liverpool", england)
+	cityOfManchesterEnded := time.Date(2011, time.July, 7, 0, 0, 0, 0, time.UTC)
+	createStadiumName(suite, etihad.ID, "City of Manchester Stadium", time.Date(2003, time.August, 10, 0, 0, 0, 0, time.UTC), &cityOfManchesterEnded)
+
+	stads, err := suite.repository.FindStadiumsByName(suite.ctx, "city of manchester stadium")
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), 1, len(stads))
+	require.Equal(suite.T(), etihad.ID, stads[0].ID)
+	require.Equal(suite.T(), "Etihad Stadium", stads[0].Name)
+
+	stads, err = suite.repository.FindStadiumsByName(suite.ctx, "Etihad Stadium")
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), 1, len(stads))
+	require.Equal(suite.T(), etihad.ID, stads[0].ID)
+}
+
+func (suite *RepositoryTestSuite) TestListStadiumsNear() {
+	england := uuid.New().String()
+	anfield := createStadiumWithLocation(suite, "Anfield", 54000, "Liverpool", england, 53.4308, -2.9608)
+	goodison := createStadiumWithLocation(suite, "Goodison Park", 39414, "Liverpool", england, 53.4388, -2.9664)
+	oldTrafford := createStadiumWithLocation(suite, "Old Trafford", 76000, "Manchester", england, 53.4631, -2.2913)
+	_ = createStadiumWithLocation(suite, "Emirates", 60000, "London", england, 51.5549, -0.1084)
+	_ = createStadium(suite, "Unknown Ground", 1000, "Nowhere", england)
+
+	radiusKm := 100.0
+	stads, err := suite.repository.ListStadiumsNear(suite.ctx, stadiums.Point{Latitude: 53.4308, Longitude: -2.9608}, &radiusKm)
+	require.NoError(suite.T(), err)
+
+	require.Equal(suite.T(), 3, len(stads))
+
+	expecteds := []stadiums.Stadium{anfield, goodison, oldTrafford}
+	for idx, exp := range expecteds {
+		require.Equal(suite.T(), exp.ID, stads[idx].ID)
+		require.Equal(suite.T(), exp.Latitude, stads[idx].Latitude)
+		require.Equal(suite.T(), exp.Longitude, stads[idx].Longitude)
+		require.NotNil(suite.T(), stads[idx].DistanceKm)
+	}
+	require.InDelta(suite.T(), 0, *stads[0].DistanceKm, 0.01)
+	require.InDelta(suite.T(), 45, *stads[2].DistanceKm, 1)
+
+	stads, err = suite.repository.ListStadiumsNear(suite.ctx, stadiums.Point{Latitude: 53.4308, Longitude: -2.9608}, nil)
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), 4, len(stads))
+}
+
+func (suite *RepositoryTestSuite) TestListStadiumsNearAntipode() {
+	anfield := createStadiumWithLocation(suite, "Anfield", 54000, "Liverpool", uuid.New().String(), 53.4308, -2.9608)
+
+	// Rounding can push the haversine term just past 1 on the far side of the world, which ASIN
+	// rejects unless it is clamped.
+	stads, err := suite.repository.ListStadiumsNear(suite.ctx, stadiums.Point{Latitude: -53.4308, Longitude: 177.0392}, nil)
+	require.NoError(suite.T(), err)
+
+	require.Equal(suite.T(), 1, len(stads))
+	require.Equal(suite.T(), anfield.ID, stads[0].ID)
+	require.InDelta(suite.T(), 20015, *stads[0].DistanceKm, 1)
+}
+
+func (suite *RepositoryTestSuite) TestListStadiumTenants() {
+	england := uuid.New().String()
+	sanSiro := createStadium(suite, "San Siro", 75817, "Milan", england)
+	inter := uuid.NewString()
+	milan := uuid.NewString()
+	formerTenant := uuid.NewString()
+	formerEnded := time.Date(1940, time.June, 30, 0, 0, 0, 0, time.UTC)
+	createTenancy(suite, formerTenant, sanSiro.ID, time.Date(1930, time.July, 1, 0, 0, 0, 0, time.UTC), &formerEnded)
+	createTenancy(suite, milan, sanSiro.ID, time.Date(1926, time.September, 19, 0, 0, 0, 0, time.UTC), nil)
+	createTenancy(suite, inter, sanSiro.ID, time.Date(1947, time.July, 1, 0, 0, 0, 0, time.UTC), nil)
+
+	tenants, err := suite.repository.ListStadiumTenants(suite.ctx, sanSiro.ID, time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC))
+	require.NoError(suite.T(), err)
+
+	require.Equal(suite.T(), 2, len(tenants))
+	require.Equal(suite.T(), milan, tenants[0].Team)
+	require.Equal(suite.T(), inter, tenants[1].Team)
+
+	tenants, err = suite.repository.ListStadiumTenants(suite.ctx, sanSiro.ID, time.Date(1935, time.January, 1, 0, 0, 0, 0, time.UTC))
+	require.NoError(suite.T(), err)
+
+	require.Equal(suite.T(), 2, len(tenants))
+	require.Equal(suite.T(), milan, tenants[0].Team)
+	require.Equal(suite.T(), formerTenant, tenants[1].Team)
+}
+
 func createStadium(suite *RepositoryTestSuite, name string, capacity int, city string, countryID string) stadiums.Stadium {
 	query := `
 	    INSERT INTO stadiums (name, capacity, city, country_id)
@@ -122,3 +230,47 @@ func createStadium(suite *RepositoryTestSuite, name string, capacity int, city s
 	require.NoError(suite.T(), err)
 	return stadium
 }
+
+func createStadiumWithLocation(suite *RepositoryTestSuite, name string, capacity int, city string, countryID string, latitude float64, longitude float64) stadiums.Stadium {
+	query := `
+	    INSERT INTO stadiums (name, capacity, city, country_id, latitude, longitude)
+	    VALUES
+	    ($1, $2, $3, $4, $5, $6)
+	    RETURNING id, name, capacity, city, country_id, latitude, longitude
+	`
+	row := suite.dbPool.QueryRow(suite.ctx, query, name, capacity, city, countryID, latitude, longitude)
+	var stadium stadiums.Stadium
+	err := row.Scan(&stadium.ID, &stadium.Name, &stadium.Capacity, &stadium.City, &stadium.Country, &stadium.Latitude, &stadium.Longitude)
+	require.NoError(suite.T(), err)
+	return stadium
+}
+
+func createTenancy(suite *RepositoryTestSuite, teamID string, stadiumID string, started time.Time, ended *time.Time) {
+	query := `
+	    INSERT INTO team_stadium_tenancies (team_id, stadium_id, started, ended)
+	    VALUES
+	    ($1, $2, $3, $4)
+	`
+	_, err := suite.dbPool.Exec(suite.ctx, query, teamID, stadiumID, started, ended)
+	require.NoError(suite.T(), err)
+}
+
+func createStadiumName(suite *RepositoryTestSuite, stadiumID string, name string, started time.Time, ended *time.Time) {
+	query := `
+	    INSERT INTO stadium_names (stadium_id, name, started, ended)
+	    VALUES
+	    ($1, $2, $3, $4)
+	`
+	_, err := suite.dbPool.Exec(suite.ctx, query, stadiumID, name, started, ended)
+	require.NoError(suite.T(), err)
+}
+
+func createStadiumCapacity(suite *RepositoryTestSuite, stadiumID string, capacity int, started time.Time, ended *time.Time) {
+	query := `
+	    INSERT INTO stadium_capacities (stadium_id, capacity, started, ended)
+	    VALUES
+	    ($1, $2, $3, $4)
+	`
+	_, err := suite.dbPool.Exec(suite.ctx, query, stadiumID, capacity, started, ended)
+	require.NoError(suite.T(), err)
+}
@@ -2,11 +2,23 @@ package stadiums
 
 import (
 	"context"
+	"github.com/pkg/errors"
+	"math"
+	"time"
 )
 
+var ErrInvalidArgument = errors.New("invalid argument")
+
 type Service interface {
 	ListStadiums(ctx context.Context) ([]Stadium, error)
-	GetStadium(ctx context.Context, id string) (Stadium, error)
+	ListStadiumsNear(ctx context.Context, point Point, radiusKm *float64) ([]Stadium, error)
+	FindStadiumsByName(ctx context.Context, name string) ([]Stadium, error)
+	StreamStadiums(ctx context.Context, yield func(Stadium) error) error
+	StreamStadiumsNear(ctx context.Context, point Point, radiusKm *float64, yield func(Stadium) error) error
+	StreamStadiumsByName(ctx context.Context, name string, yield func(Stadium) error) error
+	GetStadium(ctx context.Context, id string, asOf time.Time) (Stadium, error)
+	GetStadiums(ctx context.Context, ids []string, asOf time.Time) (map[string]Stadium, error)
+	ListStadiumTenants(ctx context.Context, id string, asOf time.Time) ([]Tenant, error)
 }
 
 func NewService(repository Repository) Service {
@@ -21,6 +33,53 @@ func (s *service) ListStadiums(ctx context.Context) ([]Stadium, error) {
 	return s.repository.ListStadiums(ctx)
 }
 
-func (s *service) GetStadium(ctx context.Context, id string) (Stadium, error) {
-	return s.repository.GetStadium(ctx, id)
+func (s *service) ListStadiumsNear(ctx context.Context, point Point, radiusKm *float64) ([]Stadium, error) {
+	if err := validateNear(point, radiusKm); err != nil {
+		return nil, err
+	}
+	return s.repository.ListStadiumsNear(ctx, point, radiusKm)
+}
+
+func (s *service) FindStadiumsByName(ctx context.Context, name string) ([]Stadium, error) {
+	return s.repository.FindStadiumsByName(ctx, name)
+}
+
+func (s *service) StreamStadiums(ctx context.Context, yield func(Stadium) error) error {
+	return s.repository.StreamStadiums(ctx, yield)
+}
+
+func (s *service) StreamStadiumsNear(ctx context.Context, point Point, radiusKm *float64, yield func(Stadium) error) error {
+	if err := validateNear(point, radiusKm); err != nil {
+		return err
+	}
+	return s.repository.StreamStadiumsNear(ctx, point, radiusKm, yield)
+}
+
+func (s *service) StreamStadiumsByName(ctx context.Context, name string, yield func(Stadium) error) error {
+	return s.repository.StreamStadiumsByName(ctx, name, yield)
+}
+
+func validateNear(point Point, radiusKm *float64) error {
+	if math.IsNaN(point.Latitude) || math.IsNaN(point.Longitude) {
+		return errors.Wrap(ErrInvalidArgument, "coordinates must be numbers")
+	}
+	if point.Latitude < -90 || point.Latitude > 90 || point.Longitude < -180 || point.Longitude > 180 {
+		return errors.Wrapf(ErrInvalidArgument, "coordinates %f,%f are out of range", point.Latitude, point.Longitude)
+	}
+	if radiusKm != nil && (*radiusKm <= 0 || math.IsNaN(*radiusKm) || math.IsInf(*radiusKm, 0)) {
+		return errors.Wrapf(ErrInvalidArgument, "radius %f must be positive and finite", *radiusKm)
+	}
+	return nil
+}
+
+func (s *service) GetStadium(ctx context.Context, id string, asOf time.Time) (Stadium, error) {
+	return s.repository.GetStadium(ctx, id, asOf)
+}
+
+func (s *service) GetStadiums(ctx context.Context, ids []string, asOf time.Time) (map[string]Stadium, error) {
+	return s.repository.GetStadiums(ctx, ids, asOf)
+}
+
+func (s *service) ListStadiumTenants(ctx context.Context, id string, asOf time.Time) ([]Tenant, error) {
+	return s.repository.ListStadiumTenants(ctx, id, asOf)
 }
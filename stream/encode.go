@@ -0,0 +1,259 @@
+package stream
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"github.com/rchauhan9/sportech/commons/go/logging"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Encode writes s to w in format. JSON keeps the usual {"<name>": [...]} shape, NDJSON writes one
+// object per line and CSV writes a header row followed by one row per item, with a column for
+// each JSON field.
+//
+// Nothing is written until the first item arrives, so if the query fails straight away the
+// error is returned with the response untouched and the caller can still send an error status.
+// If it fails after that, the status and part of the body are already on their way, so the error
+// is recorded for the access log and the response is aborted with http.ErrAbortHandler. The
+// client then sees a broken response rather than a complete-looking one with an error appended.
+func Encode[T any](ctx context.Context, w http.ResponseWriter, format string, name string, s Stream[T]) error {
+	var enc encoder[T]
+	switch format {
+	case FormatCSV:
+		enc = newCSVEncoder[T](w, name)
+	case FormatNDJSON:
+		enc = &ndjsonEncoder[T]{w: w}
+	default:
+		enc = &jsonEncoder[T]{w: w, name: name}
+	}
+
+	started := false
+	if err := s(ctx, func(item T) error {
+		started = true
+		return enc.write(item)
+	}); err != nil {
+		if started {
+			logging.RecordError(ctx, err)
+			panic(http.ErrAbortHandler)
+		}
+		return err
+	}
+	return enc.close()
+}
+
+type encoder[T any] interface {
+	write(item T) error
+	close() error
+}
+
+type jsonEncoder[T any] struct {
+	w       http.ResponseWriter
+	name    string
+	started bool
+}
+
+func (e *jsonEncoder[T]) start() error {
+	e.started = true
+	e.w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	key, err := json.Marshal(e.name)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(e.w, "{%s:[", key)
+	return err
+}
+
+func (e *jsonEncoder[T]) write(item T) error {
+	if !e.started {
+		if err := e.start(); err != nil {
+			return err
+		}
+	} else if _, err := e.w.Write([]byte(",")); err != nil {
+		return err
+	}
+	body, err := json.Marshal(item)
+	if err != nil {
+		return err
+	}
+	_, err = e.w.Write(body)
+	return err
+}
+
+func (e *jsonEncoder[T]) close() error {
+	if !e.started {
+		if err := e.start(); err != nil {
+			return err
+		}
+	}
+	_, err := e.w.Write([]byte("]}\n"))
+	return err
+}
+
+type ndjsonEncoder[T any] struct {
+	w       http.ResponseWriter
+	started bool
+}
+
+func (e *ndjsonEncoder[T]) start() {
+	e.started = true
+	e.w.Header().Set("Content-Type", "application/x-ndjson")
+}
+
+func (e *ndjsonEncoder[T]) write(item T) error {
+	if !e.started {
+		e.start()
+	}
+	body, err := json.Marshal(item)
+	if err != nil {
+		return err
+	}
+	_, err = e.w.Write(append(body, '\n'))
+	return err
+}
+
+func (e *ndjsonEncoder[T]) close() error {
+	if !e.started {
+		e.start()
+		e.w.WriteHeader(http.StatusOK)
+	}
+	return nil
+}
+
+type csvEncoder[T any] struct {
+	w       http.ResponseWriter
+	name    string
+	csv     *csv.Writer
+	columns []column
+	started bool
+}
+
+func newCSVEncoder[T any](w http.ResponseWriter, name string) *csvEncoder[T] {
+	return &csvEncoder[T]{
+		w:       w,
+		name:    name,
+		csv:     csv.NewWriter(w),
+		columns: columnsOf(reflect.TypeOf((*T)(nil)).Elem()),
+	}
+}
+
+func (e *csvEncoder[T]) start() error {
+	e.started = true
+	e.w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	e.w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", e.name+".csv"))
+	header := make([]string, len(e.columns))
+	for i, c := range e.columns {
+		header[i] = c.name
+	}
+	return e.csv.Write(header)
+}
+
+func (e *csvEncoder[T]) write(item T) error {
+	if !e.started {
+		if err := e.start(); err != nil {
+			return err
+		}
+	}
+	v := reflect.ValueOf(item)
+	record := make([]string, len(e.columns))
+	for i, c := range e.columns {
+		record[i] = escapeFormula(formatValue(v.FieldByIndex(c.index)))
+	}
+	return e.csv.Write(record)
+}
+
+func (e *csvEncoder[T]) close() error {
+	if !e.started {
+		if err := e.start(); err != nil {
+			return err
+		}
+	}
+	e.csv.Flush()
+	return e.csv.Error()
+}
+
+type column struct {
+	name  string
+	index []int
+}
+
+// columnsOf lists the fields encoding/json would write for t, in declaration order.
+func columnsOf(t reflect.Type) []column {
+	var columns []column
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		columns = append(columns, column{name: name, index: field.Index})
+	}
+	return columns
+}
+
+// escapeFormula stops spreadsheets from running a cell as a formula, by prefixing cells that
+// start with =, +, -, @, a tab or a carriage return with a quote. Numbers are left alone, as
+// they are never run and negative ones are common.
+func escapeFormula(cell string) string {
+	if cell == "" || !strings.ContainsRune("=+-@\t\r", rune(cell[0])) {
+		return cell
+	}
+	if _, err := strconv.ParseFloat(cell, 64); err == nil {
+		return cell
+	}
+	return "'" + cell
+}
+
+// formatValue renders a field as a single CSV cell. Nil pointers are empty, times at midnight
+// UTC are written as dates, and lists are joined with semicolons.
+func formatValue(v reflect.Value) string {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return ""
+		}
+		v = v.Elem()
+	}
+
+	if t, ok := v.Interface().(time.Time); ok {
+		if t.Location() == time.UTC && t.Equal(t.Truncate(24*time.Hour)) {
+			return t.Format("2006-01-02")
+		}
+		return t.Format(time.RFC3339)
+	}
+	if s, ok := v.Interface().(fmt.Stringer); ok {
+		return s.String()
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return v.String()
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10)
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, 64)
+	case reflect.Slice, reflect.Array:
+		parts := make([]string, v.Len())
+		for i := range parts {
+			parts[i] = formatValue(v.Index(i))
+		}
+		return strings.Join(parts, ";")
+	default:
+		body, _ := json.Marshal(v.Interface())
+		return string(body)
+	}
+}
@@ -0,0 +1,57 @@
+package stream
+
+import (
+	"github.com/pkg/errors"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// Formats a list can be written in.
+const (
+	FormatJSON   = "json"
+	FormatCSV    = "csv"
+	FormatNDJSON = "ndjson"
+)
+
+var mediaTypes = map[string]string{
+	"application/json":     FormatJSON,
+	"text/csv":             FormatCSV,
+	"application/x-ndjson": FormatNDJSON,
+}
+
+// Negotiate picks the format for a list response. An explicit ?format= wins; otherwise the
+// Accept header is honoured, and anything it doesn't name falls back to JSON.
+func Negotiate(r *http.Request) (string, error) {
+	if format := r.URL.Query().Get("format"); format != "" {
+		switch format {
+		case FormatJSON, FormatCSV, FormatNDJSON:
+			return format, nil
+		default:
+			return "", errors.Errorf("unsupported format %q", format)
+		}
+	}
+
+	best, bestQ := FormatJSON, 0.0
+	for _, accepted := range strings.Split(r.Header.Get("Accept"), ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(accepted))
+		if err != nil {
+			continue
+		}
+		format, ok := mediaTypes[mediaType]
+		if !ok {
+			continue
+		}
+		q := 1.0
+		if v, ok := params["q"]; ok {
+			if q, err = strconv.ParseFloat(v, 64); err != nil {
+				continue
+			}
+		}
+		if q > bestQ {
+			best, bestQ = format, q
+		}
+	}
+	return best, nil
+}
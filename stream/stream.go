@@ -0,0 +1,71 @@
+package stream
+
+import (
+	"context"
+	"reflect"
+)
+
+// Stream produces items one at a time, calling yield for each until it returns an error. It lets
+// list endpoints write rows as they are read from the database instead of collecting them first.
+type Stream[T any] func(ctx context.Context, yield func(T) error) error
+
+// Slice streams items that have already been loaded.
+func Slice[T any](items []T) Stream[T] {
+	return func(_ context.Context, yield func(T) error) error {
+		for _, item := range items {
+			if err := yield(item); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// Collect reads the whole stream into a slice.
+func (s Stream[T]) Collect(ctx context.Context) ([]T, error) {
+	var items []T
+	err := s(ctx, func(item T) error {
+		items = append(items, item)
+		return nil
+	})
+	return items, err
+}
+
+// ItemType is the type of the items in the stream. It lets the OpenAPI spec describe a stream
+// as an array.
+func (s Stream[T]) ItemType() reflect.Type {
+	return reflect.TypeOf((*T)(nil)).Elem()
+}
+
+// Wrap returns a copy of response, a struct, in which every Stream field runs through around.
+// around is given the context the stream is read with and must call run to read it, so
+// middleware can observe a list until it is drained rather than only until the endpoint returns.
+// ok is false, and response is returned as it is, when it holds no streams.
+func Wrap(response interface{}, around func(ctx context.Context, run func(ctx context.Context) error) error) (wrapped interface{}, ok bool) {
+	v := reflect.ValueOf(response)
+	if v.Kind() != reflect.Struct {
+		return response, false
+	}
+	copied := reflect.New(v.Type()).Elem()
+	copied.Set(v)
+	for i := 0; i < copied.NumField(); i++ {
+		field := copied.Field(i)
+		if !field.CanSet() || field.Kind() != reflect.Func || field.IsNil() {
+			continue
+		}
+		if _, isStream := field.Interface().(interface{ ItemType() reflect.Type }); !isStream {
+			continue
+		}
+		next := reflect.ValueOf(field.Interface())
+		field.Set(reflect.MakeFunc(field.Type(), func(args []reflect.Value) []reflect.Value {
+			ctx, _ := args[0].Interface().(context.Context)
+			err := around(ctx, func(ctx context.Context) error {
+				err, _ := next.Call([]reflect.Value{reflect.ValueOf(&ctx).Elem(), args[1]})[0].Interface().(error)
+				return err
+			})
+			return []reflect.Value{reflect.ValueOf(&err).Elem()}
+		}))
+		ok = true
+	}
+	return copied.Interface(), ok
+}
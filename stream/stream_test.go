@@ -0,0 +1,182 @@
+package stream
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+type row struct {
+	ID      string     `json:"id"`
+	Count   int32      `json:"count"`
+	Born    time.Time  `json:"born"`
+	Ended   *time.Time `json:"ended"`
+	Tags    []string   `json:"tags"`
+	Skipped string     `json:"-"`
+}
+
+var rows = []row{
+	{ID: "a", Count: 1, Born: time.Date(1990, 5, 1, 0, 0, 0, 0, time.UTC), Tags: []string{"x", "y"}},
+	{ID: "b, c", Count: 2, Born: time.Date(2001, 1, 2, 15, 4, 5, 0, time.UTC), Skipped: "hidden"},
+}
+
+func TestNegotiate(t *testing.T) {
+	tests := []struct {
+		url    string
+		accept string
+		want   string
+	}{
+		{url: "/teams/", want: FormatJSON},
+		{url: "/teams/", accept: "*/*", want: FormatJSON},
+		{url: "/teams/", accept: "text/csv", want: FormatCSV},
+		{url: "/teams/", accept: "application/x-ndjson", want: FormatNDJSON},
+		{url: "/teams/", accept: "text/csv;q=0.5, application/x-ndjson", want: FormatNDJSON},
+		{url: "/teams/", accept: "text/html, text/csv;q=0.9", want: FormatCSV},
+		{url: "/teams/?format=ndjson", accept: "text/csv", want: FormatNDJSON},
+	}
+	for _, test := range tests {
+		r := httptest.NewRequest(http.MethodGet, test.url, nil)
+		if test.accept != "" {
+			r.Header.Set("Accept", test.accept)
+		}
+		format, err := Negotiate(r)
+		require.NoError(t, err)
+		require.Equal(t, test.want, format, "%s with Accept %q", test.url, test.accept)
+	}
+
+	_, err := Negotiate(httptest.NewRequest(http.MethodGet, "/teams/?format=xml", nil))
+	require.Error(t, err)
+}
+
+func TestEncodeJSON(t *testing.T) {
+	w := httptest.NewRecorder()
+	require.NoError(t, Encode(context.Background(), w, FormatJSON, "rows", Slice(rows[:1])))
+	require.Equal(t, "application/json; charset=utf-8", w.Header().Get("Content-Type"))
+	require.JSONEq(t, `{"rows":[{"id":"a","count":1,"born":"1990-05-01T00:00:00Z","ended":null,"tags":["x","y"]}]}`, w.Body.String())
+
+	w = httptest.NewRecorder()
+	require.NoError(t, Encode(context.Background(), w, FormatJSON, "rows", Slice[row](nil)))
+	require.JSONEq(t, `{"rows":[]}`, w.Body.String())
+}
+
+func TestEncodeNDJSON(t *testing.T) {
+	w := httptest.NewRecorder()
+	require.NoError(t, Encode(context.Background(), w, FormatNDJSON, "rows", Slice(rows)))
+	require.Equal(t, "application/x-ndjson", w.Header().Get("Content-Type"))
+	require.Equal(t,
+		`{"id":"a","count":1,"born":"1990-05-01T00:00:00Z","ended":null,"tags":["x","y"]}`+"\n"+
+			`{"id":"b, c","count":2,"born":"2001-01-02T15:04:05Z","ended":null,"tags":null}`+"\n",
+		w.Body.String())
+}
+
+func TestEncodeCSV(t *testing.T) {
+	w := httptest.NewRecorder()
+	require.NoError(t, Encode(context.Background(), w, FormatCSV, "rows", Slice(rows)))
+	require.Equal(t, "text/csv; charset=utf-8", w.Header().Get("Content-Type"))
+	require.Equal(t, `attachment; filename="rows.csv"`, w.Header().Get("Content-Disposition"))
+	require.Equal(t,
+		"id,count,born,ended,tags\n"+
+			"a,1,1990-05-01,,x;y\n"+
+			"\"b, c\",2,2001-01-02T15:04:05Z,,\n",
+		w.Body.String())
+}
+
+func TestEncodeWritesNothingBeforeAnEarlyError(t *testing.T) {
+	failing := Stream[row](func(_ context.Context, _ func(row) error) error {
+		return errors.New("connection refused")
+	})
+	for _, format := range []string{FormatJSON, FormatCSV, FormatNDJSON} {
+		w := httptest.NewRecorder()
+		require.Error(t, Encode(context.Background(), w, format, "rows", failing))
+		require.False(t, w.Flushed)
+		require.Empty(t, w.Body.String(), format)
+		require.Empty(t, w.Header().Get("Content-Type"), format)
+	}
+}
+
+func TestEncodeAbortsAfterOutputHasStarted(t *testing.T) {
+	failing := Stream[row](func(_ context.Context, yield func(row) error) error {
+		if err := yield(rows[0]); err != nil {
+			return err
+		}
+		return errors.New("connection reset")
+	})
+	for _, format := range []string{FormatJSON, FormatCSV, FormatNDJSON} {
+		require.PanicsWithValue(t, http.ErrAbortHandler, func() {
+			Encode(context.Background(), httptest.NewRecorder(), format, "rows", failing)
+		}, format)
+	}
+}
+
+func TestEncodeCSVEscapesFormulas(t *testing.T) {
+	type cell struct {
+		Value string  `json:"value"`
+		Float float64 `json:"float"`
+	}
+	cells := []cell{
+		{Value: "=HYPERLINK(\"http://example.com\")", Float: -2.9608},
+		{Value: "@SUM(A1)"},
+		{Value: "+1+1"},
+		{Value: "-1"},
+		{Value: "Anfield"},
+	}
+	w := httptest.NewRecorder()
+	require.NoError(t, Encode(context.Background(), w, FormatCSV, "cells", Slice(cells)))
+	require.Equal(t,
+		"value,float\n"+
+			"\"'=HYPERLINK(\"\"http://example.com\"\")\",-2.9608\n"+
+			"'@SUM(A1),0\n"+
+			"'+1+1,0\n"+
+			"-1,0\n"+
+			"Anfield,0\n",
+		w.Body.String())
+}
+
+func TestCollect(t *testing.T) {
+	collected, err := Slice(rows).Collect(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, rows, collected)
+}
+
+func TestWrap(t *testing.T) {
+	type response struct {
+		Rows   Stream[row]
+		format string
+	}
+	type ctxKey struct{}
+
+	var observed []error
+	wrapped, ok := Wrap(response{Rows: Slice(rows), format: FormatCSV}, func(ctx context.Context, run func(context.Context) error) error {
+		err := run(context.WithValue(ctx, ctxKey{}, "wrapped"))
+		observed = append(observed, err)
+		return err
+	})
+	require.True(t, ok)
+	require.Equal(t, FormatCSV, wrapped.(response).format)
+	require.Empty(t, observed)
+
+	collected, err := wrapped.(response).Rows.Collect(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, rows, collected)
+	require.Equal(t, []error{nil}, observed)
+
+	failing := errors.New("query failed")
+	wrapped, _ = Wrap(response{Rows: func(ctx context.Context, _ func(row) error) error {
+		require.Equal(t, "wrapped", ctx.Value(ctxKey{}))
+		return failing
+	}}, func(ctx context.Context, run func(context.Context) error) error {
+		err := run(context.WithValue(ctx, ctxKey{}, "wrapped"))
+		observed = append(observed, err)
+		return err
+	})
+	_, err = wrapped.(response).Rows.Collect(context.Background())
+	require.Equal(t, failing, err)
+	require.Equal(t, []error{nil, failing}, observed)
+
+	_, ok = Wrap(struct{ ID string }{ID: "a"}, nil)
+	require.False(t, ok)
+}
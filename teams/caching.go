@@ -0,0 +1,71 @@
+package teams
+
+import (
+	"context"
+	"github.com/rchauhan9/sportech/cache"
+	"github.com/rchauhan9/sportech/stream"
+	"time"
+)
+
+// NewCachingService serves reads from store, falling through to next on a miss. Streams are
+// served from the cached list, as the teams fit comfortably in memory.
+func NewCachingService(next Service, store *cache.Store) Service {
+	return &cachingService{next: next, store: store}
+}
+
+type cachingService struct {
+	next  Service
+	store *cache.Store
+}
+
+func (s *cachingService) ListTeams(ctx context.Context, asOf time.Time) ([]Team, error) {
+	return cache.Load(s.store, cache.Key("ListTeams", asOf), func() ([]Team, error) {
+		return s.next.ListTeams(ctx, asOf)
+	})
+}
+
+func (s *cachingService) FindTeamsByName(ctx context.Context, name string, asOf time.Time) ([]Team, error) {
+	return cache.Load(s.store, cache.Key("FindTeamsByName", name, asOf), func() ([]Team, error) {
+		return s.next.FindTeamsByName(ctx, name, asOf)
+	})
+}
+
+func (s *cachingService) StreamTeams(ctx context.Context, asOf time.Time, yield func(Team) error) error {
+	teams, err := s.ListTeams(ctx, asOf)
+	if err != nil {
+		return err
+	}
+	return stream.Slice(teams)(ctx, yield)
+}
+
+func (s *cachingService) StreamTeamsByName(ctx context.Context, name string, asOf time.Time, yield func(Team) error) error {
+	teams, err := s.FindTeamsByName(ctx, name, asOf)
+	if err != nil {
+		return err
+	}
+	return stream.Slice(teams)(ctx, yield)
+}
+
+func (s *cachingService) GetTeam(ctx context.Context, id string, asOf time.Time) (Team, error) {
+	return cache.Load(s.store, cache.Key("GetTeam", id, asOf), func() (Team, error) {
+		return s.next.GetTeam(ctx, id, asOf)
+	})
+}
+
+func (s *cachingService) GetTeams(ctx context.Context, ids []string, asOf time.Time) (map[string]Team, error) {
+	return cache.LoadEach(s.store, cache.Key("GetTeams", asOf), ids, func(missing []string) (map[string]Team, error) {
+		return s.next.GetTeams(ctx, missing, asOf)
+	})
+}
+
+func (s *cachingService) ListTeamsInLeagues(ctx context.Context, leagueIDs []string, asOf time.Time) (map[string][]Team, error) {
+	return cache.LoadEach(s.store, cache.Key("ListTeamsInLeagues", asOf), leagueIDs, func(missing []string) (map[string][]Team, error) {
+		return s.next.ListTeamsInLeagues(ctx, missing, asOf)
+	})
+}
+
+func (s *cachingService) ListTeamStadiums(ctx context.Context, id string) ([]StadiumTenancy, error) {
+	return cache.Load(s.store, cache.Key("ListTeamStadiums", id), func() ([]StadiumTenancy, error) {
+		return s.next.ListTeamStadiums(ctx, id)
+	})
+}
@@ -3,34 +3,65 @@ package teams
 import (
 	"context"
 	"github.com/go-kit/kit/endpoint"
+	"github.com/rchauhan9/sportech/stream"
+	"time"
 )
 
-type listTeamsRequest struct{}
+type listTeamsRequest struct {
+	Name   string
+	AsOf   time.Time
+	Format string
+}
 
 type listTeamsResponse struct {
-	Teams []Team `json:"teams"`
+	Teams  stream.Stream[Team] `json:"teams"`
+	format string
 }
 
 type getTeamRequest struct {
-	ID string
+	ID   string
+	AsOf time.Time
 }
 
 type getTeamResponse struct {
 	Team Team `json:"team"`
 }
 
+type listTeamStadiumsRequest struct {
+	ID     string
+	Format string
+}
+
+type listTeamStadiumsResponse struct {
+	Stadiums stream.Stream[StadiumTenancy] `json:"stadiums"`
+	format   string
+}
+
 func MakeListTeamsEndpoint(svc Service) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
-		_ = request.(listTeamsRequest)
-		teams, err := svc.ListTeams(ctx)
-		return listTeamsResponse{Teams: teams}, err
+		req := request.(listTeamsRequest)
+		teams := func(ctx context.Context, yield func(Team) error) error {
+			if req.Name != "" {
+				return svc.StreamTeamsByName(ctx, req.Name, req.AsOf, yield)
+			}
+			return svc.StreamTeams(ctx, req.AsOf, yield)
+		}
+		return listTeamsResponse{Teams: teams, format: req.Format}, nil
 	}
 }
 
 func MakeGetTeamEndpoint(svc Service) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
 		req := request.(getTeamRequest)
-		team, err := svc.GetTeam(ctx, req.ID)
+		team, err := svc.GetTeam(ctx, req.ID, req.AsOf)
 		return getTeamResponse{Team: team}, err
 	}
 }
+
+func MakeListTeamStadiumsEndpoint(svc Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(listTeamStadiumsRequest)
+		stadiums, err := svc.ListTeamStadiums(ctx, req.ID)
+		return listTeamStadiumsResponse{Stadiums: stream.Slice(stadiums), format: req.Format}, err
+	}
+}
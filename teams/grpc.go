@@ -0,0 +1,153 @@
+package teams
+
+import (
+	"context"
+	"github.com/go-kit/kit/endpoint"
+	grpctransport "github.com/go-kit/kit/transport/grpc"
+	"github.com/pkg/errors"
+	"github.com/rchauhan9/sportech/auth"
+	"github.com/rchauhan9/sportech/commons/go/grpcutil"
+	"github.com/rchauhan9/sportech/commons/go/queryutil"
+	pb "github.com/rchauhan9/sportech/pb/sportech/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type grpcServer struct {
+	pb.UnimplementedTeamServiceServer
+	listTeams        grpctransport.Handler
+	getTeam          grpctransport.Handler
+	listTeamStadiums grpctransport.Handler
+}
+
+func MakeGRPCServer(listTeamsEndpoint endpoint.Endpoint, getTeamEndpoint endpoint.Endpoint, listTeamStadiumsEndpoint endpoint.Endpoint) pb.TeamServiceServer {
+	return &grpcServer{
+		listTeams: grpctransport.NewServer(
+			listTeamsEndpoint,
+			decodeGRPCListTeamsRequest,
+			encodeGRPCListTeamsResponse,
+		),
+		getTeam: grpctransport.NewServer(
+			getTeamEndpoint,
+			decodeGRPCGetTeamRequest,
+			encodeGRPCGetTeamResponse,
+		),
+		listTeamStadiums: grpctransport.NewServer(
+			listTeamStadiumsEndpoint,
+			decodeGRPCListTeamStadiumsRequest,
+			encodeGRPCListTeamStadiumsResponse,
+		),
+	}
+}
+
+func (s *grpcServer) ListTeams(ctx context.Context, req *pb.ListTeamsRequest) (*pb.ListTeamsResponse, error) {
+	_, rep, err := s.listTeams.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeGRPCError(err)
+	}
+	return rep.(*pb.ListTeamsResponse), nil
+}
+
+func (s *grpcServer) GetTeam(ctx context.Context, req *pb.GetTeamRequest) (*pb.GetTeamResponse, error) {
+	_, rep, err := s.getTeam.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeGRPCError(err)
+	}
+	return rep.(*pb.GetTeamResponse), nil
+}
+
+func (s *grpcServer) ListTeamStadiums(ctx context.Context, req *pb.ListTeamStadiumsRequest) (*pb.ListTeamStadiumsResponse, error) {
+	_, rep, err := s.listTeamStadiums.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeGRPCError(err)
+	}
+	return rep.(*pb.ListTeamStadiumsResponse), nil
+}
+
+func decodeGRPCListTeamsRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.ListTeamsRequest)
+	asOf, err := queryutil.Date("asOf", req.AsOf, ErrInvalidArgument)
+	if err != nil {
+		return nil, err
+	}
+	return listTeamsRequest{Name: req.Name, AsOf: asOf}, nil
+}
+
+func encodeGRPCListTeamsResponse(ctx context.Context, response interface{}) (interface{}, error) {
+	resp := response.(listTeamsResponse)
+	var teams []*pb.Team
+	if err := resp.Teams(ctx, func(team Team) error {
+		teams = append(teams, encodeGRPCTeam(team))
+		return nil
+	}); err != nil {
+		return nil, encodeGRPCError(err)
+	}
+	return &pb.ListTeamsResponse{Teams: teams}, nil
+}
+
+func decodeGRPCGetTeamRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.GetTeamRequest)
+	asOf, err := queryutil.Date("asOf", req.AsOf, ErrInvalidArgument)
+	if err != nil {
+		return nil, err
+	}
+	return getTeamRequest{ID: req.Id, AsOf: asOf}, nil
+}
+
+func encodeGRPCGetTeamResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(getTeamResponse)
+	return &pb.GetTeamResponse{Team: encodeGRPCTeam(resp.Team)}, nil
+}
+
+func decodeGRPCListTeamStadiumsRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.ListTeamStadiumsRequest)
+	return listTeamStadiumsRequest{ID: req.Id}, nil
+}
+
+func encodeGRPCListTeamStadiumsResponse(ctx context.Context, response interface{}) (interface{}, error) {
+	resp := response.(listTeamStadiumsResponse)
+	tenancies, err := resp.Stadiums.Collect(ctx)
+	if err != nil {
+		return nil, encodeGRPCError(err)
+	}
+	stadiums := make([]*pb.StadiumTenancy, len(tenancies))
+	for i, tenancy := range tenancies {
+		stadiums[i] = &pb.StadiumTenancy{
+			Id:      tenancy.ID,
+			Stadium: tenancy.Stadium,
+			Started: timestamppb.New(tenancy.Started),
+			Ended:   grpcutil.OptionalTimestamp(tenancy.Ended),
+		}
+	}
+	return &pb.ListTeamStadiumsResponse{Stadiums: stadiums}, nil
+}
+
+func encodeGRPCTeam(team Team) *pb.Team {
+	return &pb.Team{
+		Id:          team.ID,
+		FullName:    team.FullName,
+		MediumName:  team.MediumName,
+		Acronym:     team.Acronym,
+		Nickname:    team.Nickname,
+		YearFounded: team.YearFounded,
+		City:        team.City,
+		Country:     team.Country,
+		Stadium:     team.Stadium,
+		League:      team.League,
+	}
+}
+
+// encode errors from business-logic as gRPC statuses
+func encodeGRPCError(err error) error {
+	switch errors.Cause(err) {
+	case ErrInvalidArgument:
+		return status.Error(codes.InvalidArgument, err.Error())
+	case auth.ErrUnauthenticated:
+		return status.Error(codes.Unauthenticated, err.Error())
+	case auth.ErrForbidden:
+		return status.Error(codes.PermissionDenied, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
@@ -7,24 +7,38 @@ import (
 	kithttp "github.com/go-kit/kit/transport/http"
 	"github.com/gorilla/mux"
 	"github.com/pkg/errors"
+	"github.com/rchauhan9/sportech/auth"
+	"github.com/rchauhan9/sportech/commons/go/logging"
+	"github.com/rchauhan9/sportech/commons/go/queryutil"
+	"github.com/rchauhan9/sportech/stream"
 	"net/http"
 )
 
-func MakeHandler(listTeamsEndpoint endpoint.Endpoint, getTeamEndpoint endpoint.Endpoint) http.Handler {
+func MakeHandler(listTeamsEndpoint endpoint.Endpoint, getTeamEndpoint endpoint.Endpoint, listTeamStadiumsEndpoint endpoint.Endpoint) http.Handler {
 	r := mux.NewRouter()
 
 	listTeamsHandler := kithttp.NewServer(
 		listTeamsEndpoint,
 		decodeListTeamsRequest,
 		encodeListTeamsResponse,
+		kithttp.ServerErrorEncoder(encodeError),
 	)
 
 	getTeamHandler := kithttp.NewServer(
 		getTeamEndpoint,
 		decodeGetTeamRequest,
 		encodeGetTeamResponse,
+		kithttp.ServerErrorEncoder(encodeError),
 	)
 
+	listTeamStadiumsHandler := kithttp.NewServer(
+		listTeamStadiumsEndpoint,
+		decodeListTeamStadiumsRequest,
+		encodeListTeamStadiumsResponse,
+		kithttp.ServerErrorEncoder(encodeError),
+	)
+
+	r.Handle("/teams/{id}/stadiums", listTeamStadiumsHandler).Methods("GET")
 	r.Handle("/teams/{id}", getTeamHandler).Methods("GET")
 	r.Handle("/teams/", listTeamsHandler).Methods("GET")
 
@@ -32,8 +46,15 @@ func MakeHandler(listTeamsEndpoint endpoint.Endpoint, getTeamEndpoint endpoint.E
 }
 
 func decodeListTeamsRequest(_ context.Context, r *http.Request) (interface{}, error) {
-	_ = mux.Vars(r)
-	return listTeamsRequest{}, nil
+	asOf, err := queryutil.AsOf(r, ErrInvalidArgument)
+	if err != nil {
+		return nil, err
+	}
+	format, err := queryutil.Format(r, ErrInvalidArgument)
+	if err != nil {
+		return nil, err
+	}
+	return listTeamsRequest{Name: r.URL.Query().Get("name"), AsOf: asOf, Format: format}, nil
 }
 
 func encodeListTeamsResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
@@ -41,7 +62,8 @@ func encodeListTeamsResponse(ctx context.Context, w http.ResponseWriter, respons
 		encodeError(ctx, e.error(), w)
 		return nil
 	}
-	return json.NewEncoder(w).Encode(response)
+	resp := response.(listTeamsResponse)
+	return stream.Encode(ctx, w, resp.format, "teams", resp.Teams)
 }
 
 func decodeGetTeamRequest(_ context.Context, r *http.Request) (interface{}, error) {
@@ -50,7 +72,11 @@ func decodeGetTeamRequest(_ context.Context, r *http.Request) (interface{}, erro
 	if !ok {
 		return nil, errors.New("bad route")
 	}
-	return getTeamRequest{ID: id}, nil
+	asOf, err := queryutil.AsOf(r, ErrInvalidArgument)
+	if err != nil {
+		return nil, err
+	}
+	return getTeamRequest{ID: id, AsOf: asOf}, nil
 }
 
 func encodeGetTeamResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
@@ -61,18 +87,43 @@ func encodeGetTeamResponse(ctx context.Context, w http.ResponseWriter, response
 	return json.NewEncoder(w).Encode(response)
 }
 
+func decodeListTeamStadiumsRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	vars := mux.Vars(r)
+	id, ok := vars["id"]
+	if !ok {
+		return nil, errors.New("bad route")
+	}
+	format, err := queryutil.Format(r, ErrInvalidArgument)
+	if err != nil {
+		return nil, err
+	}
+	return listTeamStadiumsRequest{ID: id, Format: format}, nil
+}
+
+func encodeListTeamStadiumsResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	if e, ok := response.(errorer); ok && e.error() != nil {
+		encodeError(ctx, e.error(), w)
+		return nil
+	}
+	resp := response.(listTeamStadiumsResponse)
+	return stream.Encode(ctx, w, resp.format, "stadiums", resp.Stadiums)
+}
+
 type errorer interface {
 	error() error
 }
 
 // encode errors from business-logic
-func encodeError(_ context.Context, err error, w http.ResponseWriter) {
+func encodeError(ctx context.Context, err error, w http.ResponseWriter) {
+	logging.RecordError(ctx, err)
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
-	switch err {
-	//case erro:
-	//	w.WriteHeader(http.StatusNotFound)
-	//case ErrInvalidArgument:
-	//	w.WriteHeader(http.StatusBadRequest)
+	switch errors.Cause(err) {
+	case ErrInvalidArgument:
+		w.WriteHeader(http.StatusBadRequest)
+	case auth.ErrUnauthenticated:
+		w.WriteHeader(http.StatusUnauthorized)
+	case auth.ErrForbidden:
+		w.WriteHeader(http.StatusForbidden)
 	default:
 		w.WriteHeader(http.StatusInternalServerError)
 	}
@@ -1,5 +1,7 @@
 package teams
 
+import "time"
+
 type Team struct {
 	ID          string  `json:"id"`
 	FullName    string  `json:"fullName"`
@@ -12,3 +14,12 @@ type Team struct {
 	Stadium     string  `json:"stadium"`
 	League      string  `json:"league"`
 }
+
+// StadiumTenancy is a period during which a team played its home games at a stadium. Ended is
+// the last day of the tenancy, or nil if it is ongoing.
+type StadiumTenancy struct {
+	ID      string     `json:"id"`
+	Stadium string     `json:"stadium"`
+	Started time.Time  `json:"started"`
+	Ended   *time.Time `json:"ended"`
+}
@@ -0,0 +1,39 @@
+package teams
+
+import (
+	"github.com/rchauhan9/sportech/openapi"
+	"net/http"
+)
+
+var Routes = []openapi.Route{
+	{
+		Method:  http.MethodGet,
+		Path:    "/teams/",
+		Tag:     "teams",
+		Summary: "List teams",
+		Query: []openapi.Parameter{
+			openapi.QueryParam("name", "string", "Only return teams with this current or former name."),
+			openapi.AsOfParam,
+			openapi.FormatParam,
+		},
+		Response: listTeamsResponse{},
+		Formats:  true,
+	},
+	{
+		Method:   http.MethodGet,
+		Path:     "/teams/{id}",
+		Tag:      "teams",
+		Summary:  "Get a team",
+		Query:    []openapi.Parameter{openapi.AsOfParam},
+		Response: getTeamResponse{},
+	},
+	{
+		Method:   http.MethodGet,
+		Path:     "/teams/{id}/stadiums",
+		Tag:      "teams",
+		Summary:  "List the stadiums a team has played at",
+		Query:    []openapi.Parameter{openapi.FormatParam},
+		Response: listTeamStadiumsResponse{},
+		Formats:  true,
+	},
+}
@@ -4,11 +4,21 @@ import (
 	"context"
 	"github.com/jackc/pgx/v4/pgxpool"
 	"github.com/pkg/errors"
+	"time"
 )
 
+// Tables lists the tables teams, their names and their grounds are read from.
+var Tables = []string{"teams", "team_names", "team_stadium_tenancies"}
+
 type Repository interface {
-	ListTeams(ctx context.Context) ([]Team, error)
-	GetTeam(ctx context.Context, id string) (Team, error)
+	ListTeams(ctx context.Context, asOf time.Time) ([]Team, error)
+	FindTeamsByName(ctx context.Context, name string, asOf time.Time) ([]Team, error)
+	StreamTeams(ctx context.Context, asOf time.Time, yield func(Team) error) error
+	StreamTeamsByName(ctx context.Context, name string, asOf time.Time, yield func(Team) error) error
+	GetTeam(ctx context.Context, id string, asOf time.Time) (Team, error)
+	GetTeams(ctx context.Context, ids []string, asOf time.Time) (map[string]Team, error)
+	ListTeamsInLeagues(ctx context.Context, leagueIDs []string, asOf time.Time) ([]Team, error)
+	ListTeamStadiums(ctx context.Context, id string) ([]StadiumTenancy, error)
 }
 
 func NewRepository(dbPool *pgxpool.Pool) Repository {
@@ -19,27 +29,69 @@ type repository struct {
 	pool *pgxpool.Pool
 }
 
-func (r *repository) ListTeams(ctx context.Context) ([]Team, error) {
+// StreamTeams renders each team as it was on asOf. Teams without a recorded tenancy or name on
+// that date fall back to teams.stadium_id and the current names.
+func (r *repository) StreamTeams(ctx context.Context, asOf time.Time, yield func(Team) error) error {
+	return r.streamTeams(ctx, asOf, "", nil, yield)
+}
+
+// GetTeams looks up many teams as they were on asOf in one query, keyed by ID. IDs that match no
+// team are left out of the result.
+func (r *repository) GetTeams(ctx context.Context, ids []string, asOf time.Time) (map[string]Team, error) {
+	teams := make(map[string]Team, len(ids))
+	err := r.streamTeams(ctx, asOf, "WHERE t.id = ANY($2)", []interface{}{ids}, func(team Team) error {
+		teams[team.ID] = team
+		return nil
+	})
+	return teams, err
+}
+
+// ListTeamsInLeagues reads the teams of the given leagues as they were on asOf.
+func (r *repository) ListTeamsInLeagues(ctx context.Context, leagueIDs []string, asOf time.Time) ([]Team, error) {
+	var teams []Team
+	err := r.streamTeams(ctx, asOf, "WHERE t.league_id = ANY($2)", []interface{}{leagueIDs}, func(team Team) error {
+		teams = append(teams, team)
+		return nil
+	})
+	return teams, err
+}
+
+// streamTeams runs the StreamTeams query narrowed by where, which may refer to args from $2 on.
+func (r *repository) streamTeams(ctx context.Context, asOf time.Time, where string, args []interface{}, yield func(Team) error) error {
 	query := `
 		SELECT
-		    id,
-		    full_name,
-		    medium_name,
-		    acronym,
-		    nickname,
-		    year_founded,
-		    city,
-		    country_id,
-		    stadium_id,
-		    league_id
-	    FROM teams
-	`
-	rows, err := r.pool.Query(ctx, query)
+		    t.id,
+		    COALESCE(nm.full_name, t.full_name),
+		    COALESCE(nm.medium_name, t.medium_name),
+		    COALESCE(nm.acronym, t.acronym),
+		    CASE WHEN nm.id IS NULL THEN t.nickname ELSE nm.nickname END,
+		    t.year_founded,
+		    t.city,
+		    t.country_id,
+		    COALESCE(ten.stadium_id, t.stadium_id),
+		    t.league_id
+	    FROM teams t
+	    LEFT JOIN LATERAL (
+	        SELECT stadium_id
+	        FROM team_stadium_tenancies
+	        WHERE team_id = t.id AND started <= $1 AND (ended IS NULL OR ended >= $1)
+	        ORDER BY started DESC
+	        LIMIT 1
+	    ) ten ON TRUE
+	    LEFT JOIN LATERAL (
+	        SELECT id, full_name, medium_name, acronym, nickname
+	        FROM team_names
+	        WHERE team_id = t.id AND started <= $1 AND (ended IS NULL OR ended >= $1)
+	        ORDER BY started DESC
+	        LIMIT 1
+	    ) nm ON TRUE
+	` + where
+	rows, err := r.pool.Query(ctx, query, append([]interface{}{asOf}, args...)...)
 	if err != nil {
-		return nil, errors.Wrap(err, "error fetching discover category details from database")
+		return errors.Wrap(err, "error fetching discover category details from database")
 	}
 
-	var teams []Team
+	defer rows.Close()
 	for rows.Next() {
 		team := Team{}
 		if err := rows.Scan(
@@ -54,30 +106,131 @@ func (r *repository) ListTeams(ctx context.Context) ([]Team, error) {
 			&team.Stadium,
 			&team.League,
 		); err != nil {
-			return nil, errors.Wrap(err, "error scanning row from database")
+			return errors.Wrap(err, "error scanning row from database")
+		}
+		if err := yield(team); err != nil {
+			return err
 		}
+	}
+	return rows.Err()
+}
+
+func (r *repository) ListTeams(ctx context.Context, asOf time.Time) ([]Team, error) {
+	var teams []Team
+	err := r.StreamTeams(ctx, asOf, func(team Team) error {
 		teams = append(teams, team)
+		return nil
+	})
+	return teams, err
+}
+
+// StreamTeamsByName matches name case-insensitively against every current and historical full
+// name, medium name, acronym and nickname, so old names resolve to the team that carries them now.
+func (r *repository) StreamTeamsByName(ctx context.Context, name string, asOf time.Time, yield func(Team) error) error {
+	query := `
+		SELECT
+		    t.id,
+		    COALESCE(nm.full_name, t.full_name),
+		    COALESCE(nm.medium_name, t.medium_name),
+		    COALESCE(nm.acronym, t.acronym),
+		    CASE WHEN nm.id IS NULL THEN t.nickname ELSE nm.nickname END,
+		    t.year_founded,
+		    t.city,
+		    t.country_id,
+		    COALESCE(ten.stadium_id, t.stadium_id),
+		    t.league_id
+	    FROM teams t
+	    LEFT JOIN LATERAL (
+	        SELECT stadium_id
+	        FROM team_stadium_tenancies
+	        WHERE team_id = t.id AND started <= $2 AND (ended IS NULL OR ended >= $2)
+	        ORDER BY started DESC
+	        LIMIT 1
+	    ) ten ON TRUE
+	    LEFT JOIN LATERAL (
+	        SELECT id, full_name, medium_name, acronym, nickname
+	        FROM team_names
+	        WHERE team_id = t.id AND started <= $2 AND (ended IS NULL OR ended >= $2)
+	        ORDER BY started DESC
+	        LIMIT 1
+	    ) nm ON TRUE
+	    WHERE lower($1) IN (lower(t.full_name), lower(t.medium_name), lower(t.acronym), lower(t.nickname))
+	       OR EXISTS (
+	           SELECT 1
+	           FROM team_names h
+	           WHERE h.team_id = t.id
+	             AND lower($1) IN (lower(h.full_name), lower(h.medium_name), lower(h.acronym), lower(h.nickname))
+	       )
+	`
+	rows, err := r.pool.Query(ctx, query, name, asOf)
+	if err != nil {
+		return errors.Wrapf(err, "error finding teams named %s", name)
 	}
-	return teams, nil
+
+	defer rows.Close()
+	for rows.Next() {
+		team := Team{}
+		if err := rows.Scan(
+			&team.ID,
+			&team.FullName,
+			&team.MediumName,
+			&team.Acronym,
+			&team.Nickname,
+			&team.YearFounded,
+			&team.City,
+			&team.Country,
+			&team.Stadium,
+			&team.League,
+		); err != nil {
+			return errors.Wrap(err, "error scanning row from database")
+		}
+		if err := yield(team); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+func (r *repository) FindTeamsByName(ctx context.Context, name string, asOf time.Time) ([]Team, error) {
+	var teams []Team
+	err := r.StreamTeamsByName(ctx, name, asOf, func(team Team) error {
+		teams = append(teams, team)
+		return nil
+	})
+	return teams, err
 }
 
-func (r *repository) GetTeam(ctx context.Context, id string) (Team, error) {
+func (r *repository) GetTeam(ctx context.Context, id string, asOf time.Time) (Team, error) {
 	query := `
 	    SELECT
-		    id,
-		    full_name,
-		    medium_name,
-		    acronym,
-		    nickname,
-		    year_founded,
-		    city,
-		    country_id,
-		    stadium_id,
-		    league_id
-	    FROM teams
-	    WHERE id = $1
+		    t.id,
+		    COALESCE(nm.full_name, t.full_name),
+		    COALESCE(nm.medium_name, t.medium_name),
+		    COALESCE(nm.acronym, t.acronym),
+		    CASE WHEN nm.id IS NULL THEN t.nickname ELSE nm.nickname END,
+		    t.year_founded,
+		    t.city,
+		    t.country_id,
+		    COALESCE(ten.stadium_id, t.stadium_id),
+		    t.league_id
+	    FROM teams t
+	    LEFT JOIN LATERAL (
+	        SELECT stadium_id
+	        FROM team_stadium_tenancies
+	        WHERE team_id = t.id AND started <= $2 AND (ended IS NULL OR ended >= $2)
+	        ORDER BY started DESC
+	        LIMIT 1
+	    ) ten ON TRUE
+	    LEFT JOIN LATERAL (
+	        SELECT id, full_name, medium_name, acronym, nickname
+	        FROM team_names
+	        WHERE team_id = t.id AND started <= $2 AND (ended IS NULL OR ended >= $2)
+	        ORDER BY started DESC
+	        LIMIT 1
+	    ) nm ON TRUE
+	    WHERE t.id = $1
 	`
-	row := r.pool.QueryRow(ctx, query, id)
+	row := r.pool.QueryRow(ctx, query, id, asOf)
 	var team Team
 	if err := row.Scan(
 		&team.ID,
@@ -95,3 +248,36 @@ func (r *repository) GetTeam(ctx context.Context, id string) (Team, error) {
 	}
 	return team, nil
 }
+
+func (r *repository) ListTeamStadiums(ctx context.Context, id string) ([]StadiumTenancy, error) {
+	query := `
+	    SELECT
+		    id,
+		    stadium_id,
+		    started,
+		    ended
+	    FROM team_stadium_tenancies
+	    WHERE team_id = $1
+	    ORDER BY started ASC
+	`
+	rows, err := r.pool.Query(ctx, query, id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error fetching stadiums for team with id %s", id)
+	}
+	defer rows.Close()
+
+	var tenancies []StadiumTenancy
+	for rows.Next() {
+		tenancy := StadiumTenancy{}
+		if err := rows.Scan(
+			&tenancy.ID,
+			&tenancy.Stadium,
+			&tenancy.Started,
+			&tenancy.Ended,
+		); err != nil {
+			return nil, errors.Wrap(err, "error scanning row from database")
+		}
+		tenancies = append(tenancies, tenancy)
+	}
+	return tenancies, rows.Err()
+}
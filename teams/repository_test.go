@@ -14,6 +14,7 @@ import (
 	"gopkg.in/khaiql/dbcleaner.v2"
 	"gopkg.in/khaiql/dbcleaner.v2/engine"
 	"testing"
+	"time"
 )
 
 const (
@@ -63,11 +64,11 @@ func (suite *RepositoryTestSuite) TearDownSuite() {
 }
 
 func (suite *RepositoryTestSuite) SetupTest() {
-	suite.cleaner.Acquire("teams")
+	suite.cleaner.Acquire("teams", "team_names", "team_stadium_tenancies")
 }
 
 func (suite *RepositoryTestSuite) TearDownTest() {
-	suite.cleaner.Clean("teams")
+	suite.cleaner.Clean("teams", "team_names", "team_stadium_tenancies")
 }
 
 func TestRepositoryTestSuite(t *testing.T) {
@@ -81,7 +82,7 @@ func (suite *RepositoryTestSuite) TestListTeams() {
 	arsenalNickname := "The Gunners"
 	arsenal := createTeam(suite, "Arsenal Football Club", "Arsenal", "AFC", &arsenalNickname, 1882, &arsenalCity, uuid.NewString(), uuid.NewString(), uuid.NewString())
 
-	tms, err := suite.repository.ListTeams(suite.ctx)
+	tms, err := suite.repository.ListTeams(suite.ctx, time.Now())
 	require.NoError(suite.T(), err)
 
 	require.Equal(suite.T(), 2, len(tms))
@@ -105,7 +106,7 @@ func (suite *RepositoryTestSuite) TestGetTeam() {
 	liverpoolCity := "Liverpool"
 	liverpool := createTeam(suite, "Liverpool Football Club", "Liverpool", "LFC", nil, 1892, &liverpoolCity, uuid.NewString(), uuid.NewString(), uuid.NewString())
 
-	result, err := suite.repository.GetTeam(suite.ctx, liverpool.ID)
+	result, err := suite.repository.GetTeam(suite.ctx, liverpool.ID, time.Now())
 	require.NoError(suite.T(), err)
 
 	require.Equal(suite.T(), liverpool.ID, result.ID)
@@ -120,6 +121,110 @@ func (suite *RepositoryTestSuite) TestGetTeam() {
 	require.Equal(suite.T(), liverpool.League, result.League)
 }
 
+func (suite *RepositoryTestSuite) TestGetTeams() {
+	leagueID := uuid.NewString()
+	liverpool := createTeam(suite, "Liverpool Football Club", "Liverpool", "LFC", nil, 1892, nil, uuid.NewString(), uuid.NewString(), leagueID)
+	everton := createTeam(suite, "Everton Football Club", "Everton", "EFC", nil, 1878, nil, uuid.NewString(), uuid.NewString(), leagueID)
+	createTeam(suite, "Wrexham Association Football Club", "Wrexham", "WXM", nil, 1864, nil, uuid.NewString(), uuid.NewString(), uuid.NewString())
+
+	result, err := suite.repository.GetTeams(suite.ctx, []string{liverpool.ID, uuid.NewString()}, time.Now())
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), 1, len(result))
+	require.Equal(suite.T(), liverpool.FullName, result[liverpool.ID].FullName)
+
+	inLeague, err := suite.repository.ListTeamsInLeagues(suite.ctx, []string{leagueID}, time.Now())
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), 2, len(inLeague))
+	require.ElementsMatch(suite.T(), []string{liverpool.ID, everton.ID}, []string{inLeague[0].ID, inLeague[1].ID})
+}
+
+func (suite *RepositoryTestSuite) TestGetTeamResolvesStadiumAsOf() {
+	londonCity := "London"
+	tottenham := createTeam(suite, "Tottenham Hotspur Football Club", "Tottenham", "THFC", nil, 1882, &londonCity, uuid.NewString(), uuid.NewString(), uuid.NewString())
+	whiteHartLane := uuid.NewString()
+	wembley := uuid.NewString()
+	tottenhamHotspurStadium := uuid.NewString()
+	wembleyEnded := time.Date(2019, time.April, 2, 0, 0, 0, 0, time.UTC)
+	whiteHartLaneEnded := time.Date(2017, time.May, 14, 0, 0, 0, 0, time.UTC)
+	createTenancy(suite, tottenham.ID, whiteHartLane, time.Date(1899, time.September, 4, 0, 0, 0, 0, time.UTC), &whiteHartLaneEnded)
+	createTenancy(suite, tottenham.ID, wembley, time.Date(2017, time.August, 1, 0, 0, 0, 0, time.UTC), &wembleyEnded)
+	createTenancy(suite, tottenham.ID, tottenhamHotspurStadium, time.Date(2019, time.April, 3, 0, 0, 0, 0, time.UTC), nil)
+
+	result, err := suite.repository.GetTeam(suite.ctx, tottenham.ID, time.Date(2010, time.January, 1, 0, 0, 0, 0, time.UTC))
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), whiteHartLane, result.Stadium)
+
+	result, err = suite.repository.GetTeam(suite.ctx, tottenham.ID, time.Date(2018, time.January, 1, 0, 0, 0, 0, time.UTC))
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), wembley, result.Stadium)
+
+	result, err = suite.repository.GetTeam(suite.ctx, tottenham.ID, time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC))
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), tottenhamHotspurStadium, result.Stadium)
+
+	result, err = suite.repository.GetTeam(suite.ctx, tottenham.ID, time.Date(2017, time.June, 1, 0, 0, 0, 0, time.UTC))
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), tottenham.Stadium, result.Stadium)
+}
+
+func (suite *RepositoryTestSuite) TestGetTeamResolvesNameAsOf() {
+	londonCity := "London"
+	arsenalNickname := "The Gunners"
+	arsenal := createTeam(suite, "Arsenal Football Club", "Arsenal", "AFC", &arsenalNickname, 1886, &londonCity, uuid.NewString(), uuid.NewString(), uuid.NewString())
+	dialSquareEnded := time.Date(1886, time.December, 24, 0, 0, 0, 0, time.UTC)
+	createTeamName(suite, arsenal.ID, "Dial Square Football Club", "Dial Square", "DSFC", nil, time.Date(1886, time.October, 1, 0, 0, 0, 0, time.UTC), &dialSquareEnded)
+
+	result, err := suite.repository.GetTeam(suite.ctx, arsenal.ID, time.Date(1886, time.November, 1, 0, 0, 0, 0, time.UTC))
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), "Dial Square Football Club", result.FullName)
+	require.Equal(suite.T(), "Dial Square", result.MediumName)
+	require.Equal(suite.T(), "DSFC", result.Acronym)
+	require.Nil(suite.T(), result.Nickname)
+
+	result, err = suite.repository.GetTeam(suite.ctx, arsenal.ID, time.Now())
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), arsenal.FullName, result.FullName)
+	require.Equal(suite.T(), arsenal.Nickname, result.Nickname)
+}
+
+func (suite *RepositoryTestSuite) TestFindTeamsByName() {
+	londonCity := "London"
+	arsenal := createTeam(suite, "Arsenal Football Club", "Arsenal", "AFC", nil, 1886, &londonCity, uuid.NewString(), uuid.NewString(), uuid.NewString())
+	_ = createTeam(suite, "Chelsea Football Club", "Chelsea", "CFC", nil, 1905, &londonCity, uuid.NewString(), uuid.NewString(), uuid.NewString())
+	woolwichEnded := time.Date(1914, time.April, 30, 0, 0, 0, 0, time.UTC)
+	createTeamName(suite, arsenal.ID, "Woolwich Arsenal Football Club", "Woolwich Arsenal", "WAFC", nil, time.Date(1891, time.January, 1, 0, 0, 0, 0, time.UTC), &woolwichEnded)
+
+	result, err := suite.repository.FindTeamsByName(suite.ctx, "woolwich arsenal", time.Now())
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), 1, len(result))
+	require.Equal(suite.T(), arsenal.ID, result[0].ID)
+	require.Equal(suite.T(), arsenal.FullName, result[0].FullName)
+
+	result, err = suite.repository.FindTeamsByName(suite.ctx, "Arsenal", time.Now())
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), 1, len(result))
+	require.Equal(suite.T(), arsenal.ID, result[0].ID)
+}
+
+func (suite *RepositoryTestSuite) TestListTeamStadiums() {
+	londonCity := "London"
+	arsenal := createTeam(suite, "Arsenal Football Club", "Arsenal", "AFC", nil, 1886, &londonCity, uuid.NewString(), uuid.NewString(), uuid.NewString())
+	highbury := uuid.NewString()
+	emirates := uuid.NewString()
+	highburyEnded := time.Date(2006, time.May, 7, 0, 0, 0, 0, time.UTC)
+	createTenancy(suite, arsenal.ID, emirates, time.Date(2006, time.July, 22, 0, 0, 0, 0, time.UTC), nil)
+	createTenancy(suite, arsenal.ID, highbury, time.Date(1913, time.September, 6, 0, 0, 0, 0, time.UTC), &highburyEnded)
+
+	tenancies, err := suite.repository.ListTeamStadiums(suite.ctx, arsenal.ID)
+	require.NoError(suite.T(), err)
+
+	require.Equal(suite.T(), 2, len(tenancies))
+	require.Equal(suite.T(), highbury, tenancies[0].Stadium)
+	require.Equal(suite.T(), highburyEnded, *tenancies[0].Ended)
+	require.Equal(suite.T(), emirates, tenancies[1].Stadium)
+	require.Nil(suite.T(), tenancies[1].Ended)
+}
+
 func createTeam(suite *RepositoryTestSuite, fullName string, mediumName string, acronym string, nickname *string, yearFounded int, city *string, country string, stadium string, league string) teams.Team {
 	query := `
 	    INSERT INTO teams (full_name, medium_name, acronym, nickname, year_founded, city, country_id, stadium_id, league_id)
@@ -133,3 +238,23 @@ func createTeam(suite *RepositoryTestSuite, fullName string, mediumName string,
 	require.NoError(suite.T(), err)
 	return team
 }
+
+func createTenancy(suite *RepositoryTestSuite, teamID string, stadiumID string, started time.Time, ended *time.Time) {
+	query := `
+	    INSERT INTO team_stadium_tenancies (team_id, stadium_id, started, ended)
+	    VALUES
+	    ($1, $2, $3, $4)
+	`
+	_, err := suite.dbPool.Exec(suite.ctx, query, teamID, stadiumID, started, ended)
+	require.NoError(suite.T(), err)
+}
+
+func createTeamName(suite *RepositoryTestSuite, teamID string, fullName string, mediumName string, acronym string, nickname *string, started time.Time, ended *time.Time) {
+	query := `
+	    INSERT INTO team_names (team_id, full_name, medium_name, acronym, nickname, started, ended)
+	    VALUES
+	    ($1, $2, $3, $4, $5, $6, $7)
+	`
+	_, err := suite.dbPool.Exec(suite.ctx, query, teamID, fullName, mediumName, acronym, nickname, started, ended)
+	require.NoError(suite.T(), err)
+}
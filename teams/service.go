@@ -2,11 +2,22 @@ package teams
 
 import (
 	"context"
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+	"time"
 )
 
+var ErrInvalidArgument = errors.New("invalid argument")
+
 type Service interface {
-	ListTeams(ctx context.Context) ([]Team, error)
-	GetTeam(ctx context.Context, id string) (Team, error)
+	ListTeams(ctx context.Context, asOf time.Time) ([]Team, error)
+	FindTeamsByName(ctx context.Context, name string, asOf time.Time) ([]Team, error)
+	StreamTeams(ctx context.Context, asOf time.Time, yield func(Team) error) error
+	StreamTeamsByName(ctx context.Context, name string, asOf time.Time, yield func(Team) error) error
+	GetTeam(ctx context.Context, id string, asOf time.Time) (Team, error)
+	GetTeams(ctx context.Context, ids []string, asOf time.Time) (map[string]Team, error)
+	ListTeamsInLeagues(ctx context.Context, leagueIDs []string, asOf time.Time) (map[string][]Team, error)
+	ListTeamStadiums(ctx context.Context, id string) ([]StadiumTenancy, error)
 }
 
 func NewService(repository Repository) Service {
@@ -17,10 +28,42 @@ type service struct {
 	repository Repository
 }
 
-func (s *service) ListTeams(ctx context.Context) ([]Team, error) {
-	return s.repository.ListTeams(ctx)
+func (s *service) ListTeams(ctx context.Context, asOf time.Time) ([]Team, error) {
+	return s.repository.ListTeams(ctx, asOf)
+}
+
+func (s *service) FindTeamsByName(ctx context.Context, name string, asOf time.Time) ([]Team, error) {
+	return s.repository.FindTeamsByName(ctx, name, asOf)
+}
+
+func (s *service) StreamTeams(ctx context.Context, asOf time.Time, yield func(Team) error) error {
+	return s.repository.StreamTeams(ctx, asOf, yield)
+}
+
+func (s *service) StreamTeamsByName(ctx context.Context, name string, asOf time.Time, yield func(Team) error) error {
+	return s.repository.StreamTeamsByName(ctx, name, asOf, yield)
+}
+
+func (s *service) GetTeam(ctx context.Context, id string, asOf time.Time) (Team, error) {
+	return s.repository.GetTeam(ctx, id, asOf)
+}
+
+func (s *service) GetTeams(ctx context.Context, ids []string, asOf time.Time) (map[string]Team, error) {
+	return s.repository.GetTeams(ctx, ids, asOf)
+}
+
+// ListTeamsInLeagues groups the teams of the given leagues by league ID. Leagues without teams
+// are left out.
+func (s *service) ListTeamsInLeagues(ctx context.Context, leagueIDs []string, asOf time.Time) (map[string][]Team, error) {
+	teams, err := s.repository.ListTeamsInLeagues(ctx, leagueIDs, asOf)
+	if err != nil {
+		return nil, err
+	}
+	return lo.GroupBy[Team, string](teams, func(team Team) string {
+		return team.League
+	}), nil
 }
 
-func (s *service) GetTeam(ctx context.Context, id string) (Team, error) {
-	return s.repository.GetTeam(ctx, id)
+func (s *service) ListTeamStadiums(ctx context.Context, id string) ([]StadiumTenancy, error) {
+	return s.repository.ListTeamStadiums(ctx, id)
 }
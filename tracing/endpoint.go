@@ -0,0 +1,45 @@
+package tracing
+
+import (
+	"context"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/rchauhan9/sportech/commons/go/endpointutil"
+	"github.com/rchauhan9/sportech/stream"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Middleware records each request to the endpoint as a span, named as in the logs and metrics.
+// Services are called with the span's context, so the SQL they run is traced beneath it. List
+// endpoints return streams that are only read while the response is encoded, so for those the
+// span stays open until the stream is drained, and the stream is read beneath it.
+func Middleware() endpoint.Middleware {
+	return func(next endpoint.Endpoint) endpoint.Endpoint {
+		return func(ctx context.Context, request interface{}) (interface{}, error) {
+			ctx, span := tracer().Start(ctx, endpointutil.Name(request))
+
+			response, err := next(ctx, request)
+			if err == nil {
+				if wrapped, ok := stream.Wrap(response, func(streamCtx context.Context, run func(context.Context) error) error {
+					defer span.End()
+					err := run(trace.ContextWithSpan(streamCtx, span))
+					recordError(span, err)
+					return err
+				}); ok {
+					return wrapped, nil
+				}
+			}
+			recordError(span, err)
+			span.End()
+			return response, err
+		}
+	}
+}
+
+func recordError(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+}
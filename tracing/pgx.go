@@ -0,0 +1,70 @@
+package tracing
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.12.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ConfigurePool traces the statements run by connections in the pool. pgx v4 has no hook around
+// a query, but it logs each one with its duration once it completes, so the span is recorded
+// after the fact. Statements run outside a traced request, such as the rate limiter's flushes,
+// are not recorded. Query arguments are never attached to spans.
+func ConfigurePool(config *pgxpool.Config) {
+	config.ConnConfig.Logger = queryLogger{now: time.Now}
+	config.ConnConfig.LogLevel = pgx.LogLevelInfo
+}
+
+type queryLogger struct {
+	now func() time.Time
+}
+
+func (l queryLogger) Log(ctx context.Context, level pgx.LogLevel, msg string, data map[string]interface{}) {
+	switch msg {
+	case "Query", "Exec", "SendBatch", "CopyFrom":
+	default:
+		return
+	}
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		return
+	}
+	duration, ok := data["time"].(time.Duration)
+	if !ok {
+		return
+	}
+
+	endTime := l.now()
+	attributes := []attribute.KeyValue{semconv.DBSystemPostgreSQL}
+	name := msg
+	if sql, ok := data["sql"].(string); ok {
+		attributes = append(attributes, semconv.DBStatementKey.String(sql))
+		if fields := strings.Fields(sql); len(fields) > 0 {
+			name = strings.ToUpper(fields[0])
+			attributes = append(attributes, semconv.DBOperationKey.String(name))
+		}
+	}
+	if table, ok := data["tableName"].(pgx.Identifier); ok {
+		attributes = append(attributes, semconv.DBSQLTableKey.String(table.Sanitize()))
+	}
+	if rowCount, ok := data["rowCount"].(int); ok {
+		attributes = append(attributes, attribute.Int("db.rows", rowCount))
+	}
+
+	_, span := tracer().Start(ctx, name,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithTimestamp(endTime.Add(-duration)),
+		trace.WithAttributes(attributes...),
+	)
+	if err, ok := data["err"].(error); ok {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End(trace.WithTimestamp(endTime))
+}
@@ -0,0 +1,89 @@
+package tracing
+
+import (
+	"context"
+	"os"
+
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.12.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentationName = "github.com/rchauhan9/sportech/tracing"
+
+// Options chooses where spans are exported. Exporter is "otlp", to send them over OTLP/HTTP to
+// Endpoint, or to the endpoint in OTEL_EXPORTER_OTLP_ENDPOINT if Endpoint is empty; "stdout"; or
+// "file", to append them to File as JSON. SampleRatio is the fraction of new traces kept, while
+// traces started by a caller's traceparent header follow the caller's decision.
+type Options struct {
+	Exporter    string
+	Endpoint    string
+	Insecure    bool
+	File        string
+	ServiceName string
+	SampleRatio float64
+}
+
+// Start installs a global tracer provider exporting as options say, and honours W3C traceparent
+// headers on incoming requests. The function returned flushes any spans not yet exported.
+func Start(ctx context.Context, options Options) (func(context.Context) error, error) {
+	if options.SampleRatio < 0 || options.SampleRatio > 1 {
+		return nil, errors.Errorf("tracing sample ratio %v is not between 0 and 1", options.SampleRatio)
+	}
+
+	var exporter sdktrace.SpanExporter
+	var file *os.File
+	var err error
+	switch options.Exporter {
+	case "otlp":
+		var clientOptions []otlptracehttp.Option
+		if options.Endpoint != "" {
+			clientOptions = append(clientOptions, otlptracehttp.WithEndpoint(options.Endpoint))
+		}
+		if options.Insecure {
+			clientOptions = append(clientOptions, otlptracehttp.WithInsecure())
+		}
+		exporter, err = otlptracehttp.New(ctx, clientOptions...)
+	case "stdout":
+		exporter, err = stdouttrace.New(stdouttrace.WithPrettyPrint())
+	case "file":
+		file, err = os.OpenFile(options.File, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error opening trace file %s", options.File)
+		}
+		exporter, err = stdouttrace.New(stdouttrace.WithWriter(file))
+	default:
+		return nil, errors.Errorf("unknown trace exporter %q", options.Exporter)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "error creating %s trace exporter", options.Exporter)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(options.SampleRatio))),
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceNameKey.String(options.ServiceName))),
+	)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	return func(ctx context.Context) error {
+		err := provider.Shutdown(ctx)
+		if file != nil {
+			if closeErr := file.Close(); err == nil {
+				err = closeErr
+			}
+		}
+		return errors.Wrap(err, "error shutting down tracing")
+	}, nil
+}
+
+func tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
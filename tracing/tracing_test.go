@@ -0,0 +1,128 @@
+package tracing
+
+import (
+	"context"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/jackc/pgx/v4"
+	"github.com/pkg/errors"
+	"github.com/rchauhan9/sportech/stream"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	semconv "go.opentelemetry.io/otel/semconv/v1.12.0"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+type listPlayersRequest struct{}
+
+func record(t *testing.T) *tracetest.SpanRecorder {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() {
+		provider.Shutdown(context.Background())
+	})
+	return recorder
+}
+
+func TestRequestSpans(t *testing.T) {
+	recorder := record(t)
+	now := time.Date(2022, 11, 5, 15, 0, 0, 0, time.UTC)
+	logger := queryLogger{now: func() time.Time { return now }}
+
+	var e endpoint.Endpoint = func(ctx context.Context, request interface{}) (interface{}, error) {
+		logger.Log(ctx, pgx.LogLevelInfo, "Query", map[string]interface{}{
+			"sql":      "SELECT id FROM players",
+			"args":     []interface{}{},
+			"time":     250 * time.Millisecond,
+			"rowCount": 3,
+		})
+		return nil, nil
+	}
+	e = Middleware()(e)
+	handler := MakeHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		e(r.Context(), listPlayersRequest{})
+	}))
+
+	r := httptest.NewRequest(http.MethodGet, "/players/", nil)
+	r.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	handler.ServeHTTP(httptest.NewRecorder(), r)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 3)
+	query, endpointSpan, server := spans[0], spans[1], spans[2]
+
+	require.Equal(t, "HTTP GET", server.Name())
+	require.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", server.SpanContext().TraceID().String())
+	require.Equal(t, "00f067aa0ba902b7", server.Parent().SpanID().String())
+	require.Contains(t, server.Attributes(), semconv.HTTPStatusCodeKey.Int(http.StatusOK))
+
+	require.Equal(t, "tracing.listPlayers", endpointSpan.Name())
+	require.Equal(t, server.SpanContext().SpanID(), endpointSpan.Parent().SpanID())
+
+	require.Equal(t, "SELECT", query.Name())
+	require.Equal(t, endpointSpan.SpanContext().SpanID(), query.Parent().SpanID())
+	require.Contains(t, query.Attributes(), semconv.DBStatementKey.String("SELECT id FROM players"))
+	require.Equal(t, now.Add(-250*time.Millisecond), query.StartTime())
+	require.Equal(t, now, query.EndTime())
+}
+
+func TestEndpointErrorsMarkSpan(t *testing.T) {
+	recorder := record(t)
+	var e endpoint.Endpoint = func(ctx context.Context, request interface{}) (interface{}, error) {
+		return nil, errors.New("boom")
+	}
+	_, err := Middleware()(e)(context.Background(), &listPlayersRequest{})
+	require.Error(t, err)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+	require.Equal(t, "tracing.listPlayers", spans[0].Name())
+	require.Equal(t, codes.Error, spans[0].Status().Code)
+}
+
+type listPlayersResponse struct {
+	Players stream.Stream[string]
+}
+
+func TestStreamedResponsesEndSpanWhenDrained(t *testing.T) {
+	recorder := record(t)
+	var e endpoint.Endpoint = func(ctx context.Context, request interface{}) (interface{}, error) {
+		return listPlayersResponse{Players: func(ctx context.Context, yield func(string) error) error {
+			queryLogger{now: time.Now}.Log(ctx, pgx.LogLevelInfo, "Query", map[string]interface{}{
+				"sql":  "SELECT id FROM players",
+				"time": time.Millisecond,
+			})
+			return errors.New("connection reset")
+		}}, nil
+	}
+	response, err := Middleware()(e)(context.Background(), listPlayersRequest{})
+	require.NoError(t, err)
+	require.Empty(t, recorder.Ended())
+
+	err = response.(listPlayersResponse).Players(context.Background(), func(string) error { return nil })
+	require.Error(t, err)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	query, endpointSpan := spans[0], spans[1]
+	require.Equal(t, "tracing.listPlayers", endpointSpan.Name())
+	require.Equal(t, codes.Error, endpointSpan.Status().Code)
+	require.Equal(t, endpointSpan.SpanContext().SpanID(), query.Parent().SpanID())
+}
+
+func TestQueriesOutsideTracesAreNotRecorded(t *testing.T) {
+	recorder := record(t)
+	queryLogger{now: time.Now}.Log(context.Background(), pgx.LogLevelInfo, "Exec", map[string]interface{}{
+		"sql":  "DELETE FROM rate_limit_buckets",
+		"time": time.Millisecond,
+	})
+	require.Empty(t, recorder.Ended())
+}